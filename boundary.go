@@ -0,0 +1,94 @@
+package vecty
+
+// ErrorBoundary is a component which guards the rendering of its child. If
+// the child or any of its descendants panics while being reconciled, e.g.
+// because its RenderFunc panics or because of duplicate markup, the panic is
+// recovered and the boundary renders the component returned by Fallback in
+// place of the child, leaving the rest of the page intact:
+//
+//  &vecty.ErrorBoundary{
+//  	Child: &Editor{},
+//  	Fallback: func(recovered interface{}) vecty.Component {
+//  		return elem.Paragraph(vecty.Text("The editor failed to load."))
+//  	},
+//  	OnError: func(recovered interface{}, path []vecty.Component) {
+//  		log.Printf("render panic: %v at %T", recovered, path[len(path)-1])
+//  	},
+//  }
+//
+// Panics of descendants rendered on their own by Rerender are handled by
+// their closest boundary as well, and so are panics while the boundary is
+// rendered to HTML by RenderHTML or hydrated by Hydrate. Once failed, a
+// boundary keeps showing the fallback until it is rendered again by its
+// parent, when it retries to render its child.
+type ErrorBoundary struct {
+	Composite
+
+	// Child is the component guarded by the boundary.
+	Child Component
+
+	// Fallback returns the component to render in place of Child, given the
+	// value recovered from the panic.
+	Fallback func(recovered interface{}) Component
+
+	// OnError, if set, is called with the value recovered from a panic and
+	// the path of the components being reconciled when it happened, from the
+	// outermost to the innermost one. The path starts below the boundary, or
+	// below the descendant if it was rendered on its own by Rerender.
+	OnError func(recovered interface{}, path []Component)
+
+	failed    bool
+	recovered interface{}
+}
+
+// Apply implements the Markup interface.
+func (b *ErrorBoundary) Apply(element *Element) {
+	element.AddChild(b)
+}
+
+// Reconcile implements the Component interface.
+func (b *ErrorBoundary) Reconcile(oldComp Component) {
+	if oldComp, ok := oldComp.(*ErrorBoundary); ok {
+		b.Body = oldComp.Body
+	}
+	b.boundary = b
+	b.RenderFunc = b.render
+	b.ReconcileBody()
+}
+
+func (b *ErrorBoundary) render() Component {
+	if b.failed {
+		return b.Fallback(b.recovered)
+	}
+	return b.Child
+}
+
+// catch calls f and reports whether it returned normally. If f panics, the
+// work it left unfinished is discarded, the boundary is marked as failed and
+// OnError is called.
+func (b *ErrorBoundary) catch(f func()) (ok bool) {
+	pathLen := len(reconciling)
+	queued := len(afterReconcile)
+	defer func() {
+		if ok {
+			return
+		}
+		recovered := recover()
+		path := append([]Component(nil), reconciling[pathLen:]...)
+		reconciling = reconciling[:pathLen]
+		afterReconcile = afterReconcile[:queued]
+		b.fail(recovered, path)
+	}()
+	f()
+	return true
+}
+
+// fail marks the boundary as failed with the value recovered from a panic at
+// the given path and calls OnError.
+func (b *ErrorBoundary) fail(recovered interface{}, path []Component) {
+	b.failed = true
+	b.recovered = recovered
+	if b.OnError != nil {
+		b.OnError(recovered, path)
+	}
+}
@@ -0,0 +1,136 @@
+// +build !js
+
+package vecty_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+	"github.com/gopherjs/vecty/memdom"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/gopherjs/vecty/vectytest"
+)
+
+// guarded returns an error boundary around elem.Div(children...), which
+// renders "failed" as its fallback and records the recovered values.
+func guarded(recovered *[]interface{}, children ...vecty.Markup) *vecty.ErrorBoundary {
+	return &vecty.ErrorBoundary{
+		Child: elem.Div(children...),
+		Fallback: func(interface{}) vecty.Component {
+			return elem.Paragraph(vecty.Text("failed"))
+		},
+		OnError: func(r interface{}, path []vecty.Component) {
+			*recovered = append(*recovered, r)
+		},
+	}
+}
+
+func TestBoundaryRenderHTML(t *testing.T) {
+	var recovered []interface{}
+	html, err := vecty.RenderToString(elem.Div(
+		vecty.Text("before"),
+		guarded(&recovered, vecty.Text("partial"), &panicky{fail: true}),
+		vecty.Text("after"),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "<div>before<p>failed</p>after</div>"; html != want {
+		t.Errorf("got %q, want %q", html, want)
+	}
+	if len(recovered) != 1 || recovered[0] != "render failed" {
+		t.Errorf("got recovered values %v, want the panic", recovered)
+	}
+
+	html, err = vecty.RenderToString(guarded(&recovered, &panicky{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "<div><div></div></div>"; html != want {
+		t.Errorf("got %q without a panic, want %q", html, want)
+	}
+}
+
+func TestBoundaryHydrate(t *testing.T) {
+	doc := memdom.NewDocument()
+	defer vecty.SetDOM(vecty.SetDOM(doc))
+
+	// The markup of the child as rendered on the server, where it did not
+	// panic.
+	container := doc.CreateElement("body")
+	div := doc.CreateElement("div")
+	button := doc.CreateElement("button")
+	doc.InsertBefore(container, div, nil)
+	doc.InsertBefore(div, button, nil)
+	doc.InsertBefore(div, doc.CreateElement("div"), nil)
+
+	var recovered []interface{}
+	clicked := false
+	vecty.Hydrate(guarded(&recovered,
+		elem.Button(event.Click(func(*vecty.MouseEvent) {
+			clicked = true
+		})),
+		&panicky{fail: true},
+	), container)
+
+	if len(recovered) != 1 {
+		t.Errorf("got recovered values %v, want the panic", recovered)
+	}
+	if n := doc.Node(container); len(n.Children) != 1 || n.Children[0].TagName() != "p" || n.Text() != "failed" {
+		t.Errorf("got %v with text %q in the container, want the fallback", n.Children, n.Text())
+	}
+	doc.Dispatch(doc.Node(button), "click")
+	if clicked {
+		t.Error("listener of the failed child still called")
+	}
+}
+
+func TestBoundaryHydrateFallback(t *testing.T) {
+	doc := memdom.NewDocument()
+	defer vecty.SetDOM(vecty.SetDOM(doc))
+
+	// The fallback as rendered on the server, where the child panicked too.
+	container := doc.CreateElement("body")
+	p := doc.CreateElement("p")
+	doc.InsertBefore(container, p, nil)
+	doc.InsertBefore(p, doc.CreateTextNode("failed"), nil)
+	span := doc.CreateElement("span")
+	doc.InsertBefore(container, span, nil)
+
+	var recovered []interface{}
+	vecty.Hydrate(vecty.Fragment(
+		guarded(&recovered, prop.ID("child"), &panicky{fail: true}),
+		elem.Span(),
+	), container)
+
+	// Both the fallback and the following sibling adopt their nodes.
+	n := doc.Node(container)
+	if len(n.Children) != 2 || n.Children[0] != doc.Node(p) || n.Children[1] != doc.Node(span) {
+		t.Errorf("got %v in the container, want the adopted <p> and <span>", n.Children)
+	}
+	if got := n.Text(); got != "failed" {
+		t.Errorf("got text %q, want the fallback", got)
+	}
+}
+
+func TestBoundaryUnmountOnce(t *testing.T) {
+	var recovered []interface{}
+	var log []string
+	doc := vectytest.Mount(guarded(&recovered,
+		&logged{name: "Y", log: &log},
+		&logged{name: "Z", log: &log},
+	))
+	defer doc.Close()
+
+	log = nil
+	doc.Root().Update(guarded(&recovered, &logged{name: "X", log: &log, fail: true}))
+	if want := []string{"unmount Z", "unmount Y"}; !reflect.DeepEqual(log, want) {
+		t.Errorf("got %q, want %q", log, want)
+	}
+	if got := doc.Container().Text(); got != "failed" {
+		t.Errorf("got text %q, want the fallback", got)
+	}
+}
@@ -0,0 +1,135 @@
+package vecty
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Context passes a value down the component tree without handing it from
+// composite to composite. A provider created by Provide supplies a value to
+// all composites below it, which read it with Value:
+//
+//  var ThemeContext = vecty.NewContext("light")
+//
+//  // In the render function of an ancestor:
+//  return ThemeContext.Provide(p.theme, &Toolbar{}, &Content{})
+//
+//  // In the render function of a descendant:
+//  theme := ThemeContext.Value(p).(string)
+type Context struct {
+	defaultValue interface{}
+}
+
+// NewContext returns a new context whose value is defaultValue for composites
+// without a provider of the context above them.
+func NewContext(defaultValue interface{}) *Context {
+	return &Context{defaultValue: defaultValue}
+}
+
+// Provide returns a component which renders the given children in place of
+// itself, like Fragment, and supplies value to the composites below them.
+// When a provider is rendered with a different value than before, all
+// composites which have read the old value are rendered again, even those
+// which skipped rendering, before the render of the provider completes.
+func (ctx *Context) Provide(value interface{}, children ...Markup) Component {
+	return &provider{context: ctx, value: value, body: Fragment(children...)}
+}
+
+// Value returns the value supplied by the closest provider of the context
+// above comp, which must embed Composite, or the default value of the context
+// if there is none. It must be called while comp renders, and makes comp
+// depend on the provider, so that it is rendered again when the value
+// changes.
+func (ctx *Context) Value(comp Component) interface{} {
+	cc, ok := comp.(compositeComponent)
+	if !ok {
+		panic(fmt.Sprintf("vecty: Context.Value called on %T, which does not embed Composite", comp))
+	}
+	for c := cc.composite().parent; c != nil; c = c.parent {
+		if p := c.provider; p != nil && p.context == ctx {
+			p.addDependent(comp)
+			return p.value
+		}
+	}
+	return ctx.defaultValue
+}
+
+type provider struct {
+	Composite
+	context    *Context
+	value      interface{}
+	body       Component
+	dependents []Component
+}
+
+// Apply implements the Markup interface.
+func (p *provider) Apply(element *Element) {
+	element.AddChild(p)
+}
+
+// Reconcile implements the Component interface.
+func (p *provider) Reconcile(oldComp Component) {
+	if oldComp, ok := oldComp.(*provider); ok {
+		p.Body = oldComp.Body
+		if oldComp.context == p.context {
+			changed := !sameValue(p.value, oldComp.value)
+			var stale []*Composite
+			for _, d := range oldComp.dependents {
+				c := d.(compositeComponent).composite()
+				if c.superseded || c.unmounted {
+					continue
+				}
+				p.dependents = append(p.dependents, d)
+				if changed {
+					stale = append(stale, c)
+				}
+			}
+			if len(stale) != 0 {
+				later(func() {
+					rerenderStale(stale)
+				})
+			}
+		}
+	}
+	p.provider = p
+	p.RenderFunc = p.render
+	p.ReconcileBody()
+}
+
+// rerenderStale renders the dependents of a provider again whose value has
+// changed, parents first. Dependents which have been rendered again along
+// with the provider are superseded by then and skipped.
+func rerenderStale(stale []*Composite) {
+	sort.Stable(byDepth(stale))
+	for _, c := range stale {
+		if !c.superseded && !c.unmounted && c.Body != nil {
+			c.ReconcileBody()
+		}
+	}
+}
+
+func (p *provider) render() Component {
+	return p.body
+}
+
+func (p *provider) addDependent(comp Component) {
+	for _, d := range p.dependents {
+		if d == comp {
+			return
+		}
+	}
+	p.dependents = append(p.dependents, comp)
+}
+
+// sameValue reports whether a and b are equal, without panicking on values
+// which are not comparable.
+func sameValue(a, b interface{}) bool {
+	if a == nil || b == nil || reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return a == nil && b == nil
+	}
+	if reflect.TypeOf(a).Comparable() {
+		return a == b
+	}
+	return reflect.DeepEqual(a, b)
+}
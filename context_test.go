@@ -0,0 +1,67 @@
+// +build !js
+
+package vecty_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/vectytest"
+)
+
+var labelContext = vecty.NewContext("default")
+
+// label renders the value of labelContext and always skips rendering when its
+// parent renders.
+type label struct {
+	vecty.Composite
+
+	renders int
+}
+
+func (l *label) Apply(element *vecty.Element) {
+	element.AddChild(l)
+}
+
+func (l *label) SkipRender(prev vecty.Component) bool {
+	return true
+}
+
+func (l *label) Reconcile(oldComp vecty.Component) {
+	l.RenderFunc = func() vecty.Component {
+		l.renders++
+		return elem.Span(vecty.Text(labelContext.Value(l).(string)))
+	}
+	l.ReconcileBody()
+}
+
+func TestContext(t *testing.T) {
+	inner, outer := &label{}, &label{}
+	tree := func(value string) vecty.Component {
+		return elem.Div(
+			labelContext.Provide(value, elem.Section(inner)),
+			outer,
+		)
+	}
+	doc := vectytest.Mount(tree("one"))
+	defer doc.Close()
+	if got := doc.Container().Text(); got != "onedefault" {
+		t.Errorf("got text %q, want %q", got, "onedefault")
+	}
+
+	doc.Root().Update(tree("one"))
+	if inner.renders != 1 {
+		t.Errorf("got %d renders with the same value, want 1", inner.renders)
+	}
+
+	// The label skips rendering, but is rendered again with the new value
+	// right away.
+	doc.Root().Update(tree("two"))
+	if got := doc.Container().Text(); got != "twodefault" {
+		t.Errorf("got text %q after changing the value, want %q", got, "twodefault")
+	}
+	if inner.renders != 2 || outer.renders != 1 {
+		t.Errorf("got %d and %d renders, want 2 and 1", inner.renders, outer.renders)
+	}
+}
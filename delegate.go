@@ -0,0 +1,190 @@
+package vecty
+
+import "github.com/gopherjs/gopherjs/js"
+
+// RenderOption configures how Render, RenderAsBody and Hydrate render a
+// component tree.
+type RenderOption func(o *renderOptions)
+
+type renderOptions struct {
+	delegateEvents bool
+}
+
+// DelegateEvents returns a RenderOption which makes the rendered tree handle
+// its events through a single DOM event listener per event name on the
+// container, instead of one per element. The listener dispatches an event to
+// the EventListeners of the elements from its target up to the container,
+// innermost first, as if it bubbled through them, until one of them stops its
+// propagation. This saves registering listeners on every element of large
+// trees, such as tables with thousands of rows.
+//
+// Events which do not bubble, like focus and scroll, listeners with options
+// such as Capture, and events of elements rendered by a Portal are still
+// handled by listeners on the elements. As the browser calls these listeners
+// while the event bubbles up to the container, they are called before all
+// delegated listeners of the event, even those of descendants, and a delegated
+// listener cannot stop the propagation of the event to them. For example, a
+// Passive click listener of an element is called before a click listener of
+// its child which stops the propagation.
+func DelegateEvents() RenderOption {
+	return func(o *renderOptions) {
+		o.delegateEvents = true
+	}
+}
+
+// delegating is the delegation root of the components currently being
+// reconciled, or nil if their elements listen to events themselves.
+var delegating *delegationRoot
+
+// delegationRoot listens to the events of a tree rendered with DelegateEvents
+// on its container.
+type delegationRoot struct {
+	comp      Component
+	container *js.Object
+	listening map[string]func()
+}
+
+// newDelegationRoot returns the delegation root for rendering comp into
+// container with the given options, or nil if events are not delegated.
+func newDelegationRoot(comp Component, container *js.Object, opts []RenderOption) *delegationRoot {
+	var o renderOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if !o.delegateEvents {
+		return nil
+	}
+	return &delegationRoot{
+		comp:      comp,
+		container: container,
+		listening: make(map[string]func()),
+	}
+}
+
+// withDelegation calls f with delegating set to r.
+func withDelegation(r *delegationRoot, f func()) {
+	prev := delegating
+	delegating = r
+	defer func() {
+		delegating = prev
+	}()
+	f()
+}
+
+// nonBubblingEvents are the events which do not bubble and thus cannot be
+// delegated to the container.
+var nonBubblingEvents = map[string]bool{
+	"abort":          true,
+	"blur":           true,
+	"cancel":         true,
+	"canplay":        true,
+	"canplaythrough": true,
+	"close":          true,
+	"durationchange": true,
+	"emptied":        true,
+	"ended":          true,
+	"error":          true,
+	"focus":          true,
+	"invalid":        true,
+	"load":           true,
+	"loadeddata":     true,
+	"loadedmetadata": true,
+	"loadstart":      true,
+	"mouseenter":     true,
+	"mouseleave":     true,
+	"pause":          true,
+	"play":           true,
+	"playing":        true,
+	"pointerenter":   true,
+	"pointerleave":   true,
+	"progress":       true,
+	"ratechange":     true,
+	"scroll":         true,
+	"seeked":         true,
+	"seeking":        true,
+	"stalled":        true,
+	"suspend":        true,
+	"timeupdate":     true,
+	"toggle":         true,
+	"volumechange":   true,
+	"waiting":        true,
+}
+
+// listen makes sure that the events of the given name are dispatched by r.
+func (r *delegationRoot) listen(name string) {
+	if _, ok := r.listening[name]; ok {
+		return
+	}
+	r.listening[name] = dom.AddEventListener(r.container, name, ListenerOptions{}, func(jsEvent *js.Object) {
+		r.dispatch(name, jsEvent)
+	})
+}
+
+// stop removes the event listeners of r from the container.
+func (r *delegationRoot) stop() {
+	for name, remove := range r.listening {
+		remove()
+		delete(r.listening, name)
+	}
+}
+
+// dispatch calls the delegated listeners for an event, from its target up to
+// the container.
+func (r *delegationRoot) dispatch(name string, jsEvent *js.Object) {
+	var nodes []*js.Object
+	node := dom.EventTarget(jsEvent)
+	for node != nil && node != r.container {
+		nodes = append(nodes, node)
+		node = dom.ParentNode(node)
+	}
+	if node == nil {
+		return // the target is no longer part of the container
+	}
+
+	// Find the elements of the nodes by walking down the component tree, as
+	// the nodes do not refer to their elements.
+	var elements []*Element
+	comps := []Component{r.comp}
+	for i := len(nodes) - 1; i >= 0; i-- {
+		e := findElement(comps, nodes[i])
+		if e == nil {
+			break
+		}
+		elements = append(elements, e)
+		comps = e.Children
+	}
+
+	for i := len(elements) - 1; i >= 0; i-- {
+		h, ok := elements[i].handlers[listenerKey{name: name}]
+		if !ok || h.remove != nil {
+			continue // not delegated
+		}
+		if h.dispatch(jsEvent, elements[i].node) {
+			return
+		}
+	}
+}
+
+// findElement returns the element among comps, looking into composites and
+// fragments, whose node is node.
+func findElement(comps []Component, node *js.Object) *Element {
+	for _, c := range comps {
+		switch c := c.(type) {
+		case *Element:
+			if c.node == node {
+				return c
+			}
+		case *fragment:
+			if e := findElement(c.children, node); e != nil {
+				return e
+			}
+		case compositeComponent:
+			if body := c.composite().Body; body != nil {
+				if e := findElement([]Component{body}, node); e != nil {
+					return e
+				}
+			}
+		}
+	}
+	return nil
+}
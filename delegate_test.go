@@ -0,0 +1,69 @@
+// +build !js
+
+package vecty_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+	"github.com/gopherjs/vecty/vectytest"
+)
+
+func TestDelegateEvents(t *testing.T) {
+	var calls []string
+	click := func(name string) *vecty.EventListener {
+		return event.Click(func(*vecty.MouseEvent) {
+			calls = append(calls, name)
+		})
+	}
+	doc := vectytest.Mount(elem.Div(
+		click("outer"),
+		elem.Button(click("inner")),
+		elem.Anchor(click("stop").StopPropagation()),
+	), vecty.DelegateEvents())
+	defer doc.Close()
+
+	doc.Click(doc.ByTag("button")[0])
+	doc.Click(doc.ByTag("a")[0])
+	if want := []string{"inner", "outer", "stop"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("got calls %q, want %q", calls, want)
+	}
+
+	// A single listener on the container handles the events.
+	if got, want := listenerOps(doc), []string{"addEventListener #1 <body> click"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got listeners %q, want %q", got, want)
+	}
+}
+
+func TestDelegateNonBubblingEvents(t *testing.T) {
+	doc := vectytest.Mount(elem.Form(
+		elem.Input(event.Invalid(func(*vecty.Event) {})),
+		elem.Dialog(event.Close(func(*vecty.Event) {})),
+	), vecty.DelegateEvents())
+	defer doc.Close()
+
+	// The events do not bubble to the container, so the elements listen to
+	// them themselves.
+	want := []string{
+		"addEventListener #3 <input> invalid",
+		"addEventListener #4 <dialog> close",
+	}
+	if got := listenerOps(doc); !reflect.DeepEqual(got, want) {
+		t.Errorf("got listeners %q, want %q", got, want)
+	}
+}
+
+// listenerOps returns the operations adding event listeners performed on doc.
+func listenerOps(doc *vectytest.Document) []string {
+	var ops []string
+	for _, op := range doc.Ops() {
+		if strings.HasPrefix(op, "addEventListener") {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
@@ -1,6 +1,11 @@
 package vecty
 
-import "github.com/gopherjs/gopherjs/js"
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
 
 // Component represents a Vecty component.
 type Component interface {
@@ -9,26 +14,338 @@ type Component interface {
 	Node() *js.Object
 }
 
+// Mounter is implemented by components which need to know when they have
+// been inserted into the document, e.g. to start timers or to subscribe to
+// stores.
+//
+// A component which takes over the place of a previous one, e.g. when its
+// parent renders again, is not mounted, and the previous one is not
+// unmounted. Instead, Updated is called on the new component, which has to
+// take over whatever the previous one set up in Mount, such as a subscription
+// calling Rerender on it: the previous component is no longer rendered, so
+// its Rerender calls have no effect. See Updater.
+type Mounter interface {
+	// Mount is called after the component has been inserted into the
+	// document. Descendants are mounted before their ancestors.
+	Mount()
+}
+
+// Unmounter is implemented by components which need to clean up when they are
+// removed from the document.
+type Unmounter interface {
+	// Unmount is called before the component is removed from the document,
+	// either on its own or as part of a removed subtree. Ancestors are
+	// unmounted before their descendants. It is not called on a component
+	// whose place is taken over by a new one, see Mounter.
+	Unmount()
+}
+
+// Updater is implemented by components which need to know when they have
+// taken over the place of a previous component in the tree, e.g. to take over
+// the subscriptions the previous component made in Mount:
+//
+//  func (p *PageView) Updated(oldComp vecty.Component) {
+//  	store.Listeners.Remove(oldComp)
+//  	p.Mount()
+//  }
+type Updater interface {
+	// Updated is called after the component has been reconciled against
+	// oldComp, reusing its DOM, instead of being mounted as a new component.
+	// oldComp is not unmounted. It may be the component itself, if it is
+	// rendered again in the same place, e.g. by Root.Update. Descendants are
+	// updated before their ancestors.
+	Updated(oldComp Component)
+}
+
+// RenderSkipper is implemented by components which can tell when rendering
+// them again would not change the result, e.g. because their props are equal
+// to the ones of the previous render.
+type RenderSkipper interface {
+	// SkipRender is called before the component is reconciled against prev,
+	// a component of the same type whose place it takes in the tree. If it
+	// returns true, prev is kept in the tree instead, together with its body
+	// and DOM, and the component is discarded. See PropsEqual.
+	SkipRender(prev Component) bool
+}
+
+// Root is a component tree rendered into a container by Render, RenderAsBody
+// or Hydrate. Several roots can live on the same page independently of each
+// other, e.g. widgets embedded into a page which is not rendered by Vecty.
+type Root struct {
+	comp       Component
+	container  *js.Object
+	delegation *delegationRoot
+	unmounted  bool
+}
+
+// Container returns the element which the root has been rendered into.
+func (r *Root) Container() *js.Object {
+	return r.container
+}
+
+// Update renders comp in place of the component rendered so far, reconciling
+// it against the latter, like a parent element does with its children. If
+// reconciling comp panics, the root keeps the component rendered so far.
+func (r *Root) Update(comp Component) {
+	old := r.comp
+	if sameComponent(comp, old) && skipRender(comp, old) {
+		return
+	}
+	withDelegation(r.delegation, func() {
+		reconcile(func() {
+			oldNodes := componentNodes(old)
+			ref := dom.NextSibling(oldNodes[len(oldNodes)-1])
+			reconcileComponent(comp, old)
+			r.comp = comp
+			if r.delegation != nil {
+				r.delegation.comp = comp
+			}
+			same := sameComponent(comp, old)
+			if !same {
+				unmount(old)
+			}
+			nodes := componentNodes(comp)
+			placeNodes(r.container, nodes, ref)
+			for _, n := range oldNodes {
+				if !containsNode(nodes, n) {
+					removeNode(n)
+				}
+			}
+			if same {
+				updated(comp, old)
+				return
+			}
+			mount(comp)
+		})
+	})
+}
+
+// Unmount removes the rendered component from the container, calling Unmount
+// on all components which implement Unmounter and removing all event
+// listeners. The root cannot be used anymore afterwards, and unmounting it
+// again has no effect.
+func (r *Root) Unmount() {
+	if r.unmounted {
+		return
+	}
+	r.unmounted = true
+	unmount(r.comp)
+	removeNodes(componentNodes(r.comp))
+	if r.delegation != nil {
+		r.delegation.stop()
+	}
+}
+
 // Render renders a component into the given container element. It is appended
 // as a child element.
-func Render(comp Component, container *js.Object) {
-	comp.Reconcile(nil)
-	container.Call("appendChild", comp.Node())
+func Render(comp Component, container *js.Object, opts ...RenderOption) *Root {
+	r := &Root{
+		comp:       comp,
+		container:  container,
+		delegation: newDelegationRoot(comp, container, opts),
+	}
+	withDelegation(r.delegation, func() {
+		reconcile(func() {
+			reconcileComponent(comp, nil)
+			insertNodes(container, componentNodes(comp), nil)
+			mount(comp)
+		})
+	})
+	return r
 }
 
 // RenderAsBody renders the given component as the body of the page, replacing
 // whatever existing content in the page body there may be.
-func RenderAsBody(comp Component) {
-	doc := js.Global.Get("document")
-	body := doc.Call("createElement", "body")
-	Render(comp, body)
-	if doc.Get("readyState").String() == "loading" {
-		doc.Call("addEventListener", "DOMContentLoaded", func() { // avoid duplicate body
-			doc.Set("body", body)
+func RenderAsBody(comp Component, opts ...RenderOption) *Root {
+	doc := dom.Document()
+	body := dom.CreateElement("body")
+	r := &Root{
+		comp:       comp,
+		container:  body,
+		delegation: newDelegationRoot(comp, body, opts),
+	}
+	withDelegation(r.delegation, func() {
+		reconcile(func() {
+			reconcileComponent(comp, nil)
+			insertNodes(body, componentNodes(comp), nil)
 		})
+	})
+	if dom.Property(doc, "readyState") == "loading" {
+		dom.AddEventListener(doc, "DOMContentLoaded", ListenerOptions{Once: true}, func(*js.Object) { // avoid duplicate body
+			dom.SetBody(body)
+			mount(comp)
+		})
+		return r
+	}
+	dom.SetBody(body)
+	mount(comp)
+	return r
+}
+
+// sameComponent reports whether c takes over the place of old when reconciled
+// against it, as opposed to replacing it by a new component.
+func sameComponent(c, old Component) bool {
+	if reflect.TypeOf(c) != reflect.TypeOf(old) {
+		return false
+	}
+	if e, ok := c.(*Element); ok {
+		return e.sameElement(old.(*Element))
+	}
+	return true
+}
+
+// childComponents returns the components directly below c in the tree.
+func childComponents(c Component) []Component {
+	switch c := c.(type) {
+	case *Element:
+		return c.Children
+	case *fragment:
+		return c.children
+	case *portal:
+		return c.children
+	case compositeComponent:
+		if body := c.composite().Body; body != nil {
+			return []Component{body}
+		}
+	}
+	return nil
+}
+
+var (
+	// reconcileDepth is the number of nested calls to reconcile.
+	reconcileDepth int
+
+	// afterReconcile are the functions queued by later.
+	afterReconcile []func()
+)
+
+// reconcile calls f, which reconciles components. The lifecycle methods called
+// by f through later are delayed until the outermost call to reconcile
+// returns, when the DOM is complete. If the outermost call panics, they are
+// discarded, as the components they belong to may never have reached the DOM.
+func reconcile(f func()) {
+	if reconcileDepth != 0 {
+		reconcileDepth++
+		defer func() {
+			reconcileDepth--
+		}()
+		f()
+		return
+	}
+
+	pathLen := len(reconciling)
+	completed := false
+	defer func() {
+		if !completed {
+			reconcileDepth = 0
+			reconciling = reconciling[:pathLen]
+			afterReconcile = nil
+		}
+	}()
+	reconcileDepth++
+	f()
+	reconcileDepth--
+	for len(afterReconcile) != 0 {
+		queue := afterReconcile
+		afterReconcile = nil
+		for _, f := range queue {
+			f()
+		}
+	}
+	completed = true
+}
+
+// reconciling is the path of the components currently being reconciled, from
+// the outermost to the innermost one.
+var reconciling []Component
+
+// reconcileComponent reconciles c against old, keeping track of the path of
+// components being reconciled. If c panics, it stays on the path.
+func reconcileComponent(c, old Component) {
+	reconciling = append(reconciling, c)
+	c.Reconcile(old)
+	reconciling = reconciling[:len(reconciling)-1]
+}
+
+// later calls f once the outermost call to reconcile returns, or right away if
+// there is none.
+func later(f func()) {
+	if reconcileDepth == 0 {
+		f()
 		return
 	}
-	doc.Set("body", body)
+	afterReconcile = append(afterReconcile, f)
+}
+
+// mount calls Mount on c and all of its descendants, descendants first, once
+// the DOM is complete.
+func mount(c Component) {
+	later(func() {
+		mountTree(c)
+	})
+}
+
+func mountTree(c Component) {
+	for _, child := range childComponents(c) {
+		mountTree(child)
+	}
+	if m, ok := c.(Mounter); ok {
+		m.Mount()
+	}
+}
+
+// unmount calls Unmount on c and all of its descendants, ancestors first.
+// Composites which have been unmounted already, e.g. by reconcileChildren
+// before a panic recovered by an error boundary, are skipped with their
+// descendants.
+func unmount(c Component) {
+	if cc, ok := c.(compositeComponent); ok {
+		if cc.composite().unmounted {
+			return
+		}
+		cc.composite().unmounted = true
+	}
+	if u, ok := c.(Unmounter); ok {
+		u.Unmount()
+	}
+	for _, child := range childComponents(c) {
+		unmount(child)
+	}
+	switch c := c.(type) {
+	case *Element:
+		for _, r := range c.refs {
+			r.setNode(nil)
+		}
+		for _, h := range c.handlers {
+			if h.remove != nil {
+				h.remove()
+			}
+		}
+		c.handlers = nil
+	case *portal:
+		c.remove()
+	}
+}
+
+// skipRender reports whether c, which is of the same kind as old, can be
+// replaced by old in the tree instead of being reconciled.
+func skipRender(c, old Component) bool {
+	s, ok := c.(RenderSkipper)
+	return ok && s.SkipRender(old)
+}
+
+// updated records that c has taken over the place of old and calls Updated on
+// c once the DOM is complete. c and old may be the same component, e.g. a
+// child which its parent keeps across renders, which then stays in the tree.
+func updated(c, old Component) {
+	if cc, ok := old.(compositeComponent); ok && old != c {
+		cc.composite().superseded = true
+	}
+	if u, ok := c.(Updater); ok {
+		later(func() {
+			u.Updated(old)
+		})
+	}
 }
 
 type textComponent struct {
@@ -45,12 +362,12 @@ func (s *textComponent) Reconcile(oldComp Component) {
 	if oldText, ok := oldComp.(*textComponent); ok {
 		s.node = oldText.node
 		if oldText.text != s.text {
-			s.node.Set("nodeValue", s.text)
+			dom.SetNodeValue(s.node, s.text)
 		}
 		return
 	}
 
-	s.node = js.Global.Get("document").Call("createTextNode", s.text)
+	s.node = dom.CreateTextNode(s.text)
 }
 
 func (s *textComponent) Node() *js.Object {
@@ -63,17 +380,121 @@ func Text(text string) Component {
 	return &textComponent{text: text}
 }
 
+type fragment struct {
+	children    []Component
+	placeholder *js.Object
+}
+
+// Apply implements the Markup interface.
+func (f *fragment) Apply(element *Element) {
+	element.Children = append(element.Children, f)
+}
+
+func (f *fragment) Reconcile(oldComp Component) {
+	if oldFragment, ok := oldComp.(*fragment); ok {
+		f.placeholder = oldFragment.placeholder
+		reconcileChildren(f.children, oldFragment.children)
+	} else {
+		for _, c := range f.children {
+			reconcileComponent(c, nil)
+		}
+	}
+	switch {
+	case len(childNodes(f.children)) != 0 && f.placeholder != nil:
+		removeNode(f.placeholder)
+		f.placeholder = nil
+	case len(childNodes(f.children)) == 0 && f.placeholder == nil:
+		// An empty fragment still needs a node to mark its place.
+		f.placeholder = dom.CreateTextNode("")
+	}
+}
+
+// nodes returns the nodes of the children of the fragment in order, or its
+// placeholder if there are none.
+func (f *fragment) nodes() []*js.Object {
+	if nodes := childNodes(f.children); len(nodes) != 0 {
+		return nodes
+	}
+	return []*js.Object{f.placeholder}
+}
+
+func (f *fragment) Node() *js.Object {
+	return f.nodes()[0]
+}
+
+// Fragment returns a component which renders the given children as siblings
+// in place of itself, without an element wrapping them. This allows a
+// composite to render e.g. several table cells or a <dt>/<dd> pair:
+//
+//  return vecty.Fragment(
+//  	elem.DefinitionTerm(vecty.Text(p.Term)),
+//  	elem.Description(vecty.Text(p.Description)),
+//  )
+//
+// Only components, and markup resolving to components such as List and If, may
+// be passed to Fragment.
+func Fragment(children ...Markup) Component {
+	return &fragment{children: componentsOnly(children, "fragment")}
+}
+
+// componentsOnly returns the components which children resolve to, and panics
+// if they include any other markup, naming what they were passed to.
+func componentsOnly(children []Markup, what string) []Component {
+	e := &Element{}
+	List(children).Apply(e)
+	rest := *e
+	rest.Children = nil
+	if !reflect.DeepEqual(rest, Element{}) {
+		panic(fmt.Sprintf("vecty: %s markup must be components", what))
+	}
+	return e.Children
+}
+
+// Keyer is implemented by components which carry a key identifying them
+// among their siblings. When an element is reconciled, its children are
+// matched with the old children by key rather than by position, so that a
+// keyed child which has moved keeps its DOM node and state.
+type Keyer interface {
+	Key() interface{}
+}
+
+// Namespaces of non-HTML elements.
+const (
+	SVGNamespace    = "http://www.w3.org/2000/svg"
+	MathMLNamespace = "http://www.w3.org/1998/Math/MathML"
+)
+
 // Element is a Component which virtually represents a DOM element.
+//
+// If Namespace is set, the element is created in that namespace instead of as
+// an HTML element and its Properties are set as attributes. Elements without
+// a namespace inherit the namespace of their closest ancestor element, also
+// when they are rendered by fragments or composites, except below an SVG
+// <foreignObject> and in portals.
+//
+// If UnsafeHTML is set, it is used as the inner HTML of the element, which
+// then must not have any children.
 type Element struct {
 	TagName        string
+	Namespace      string
 	Properties     map[string]interface{}
+	Attributes     map[string]interface{}
 	Style          map[string]interface{}
 	Dataset        map[string]string
 	EventListeners []*EventListener
 	Children       []Component
+	UnsafeHTML     string
+	key            interface{}
+	refs           []ref
+	handlers       map[listenerKey]*eventHandler
 	node           *js.Object
 }
 
+// Key implements the Keyer interface.
+func (e *Element) Key() interface{} {
+	return e.key
+}
+
 // AddChild adds a child component.
 func (e *Element) AddChild(s Component) {
 	e.Children = append(e.Children, s)
@@ -86,91 +507,390 @@ func (e *Element) Apply(element *Element) {
 
 // Reconcile implements the Component interface.
 func (e *Element) Reconcile(oldComp Component) {
-	for _, l := range e.EventListeners {
-		l.wrapper = func(jsEvent *js.Object) {
-			if l.callPreventDefault {
-				jsEvent.Call("preventDefault")
-			}
-			if l.callStopPropagation {
-				jsEvent.Call("stopPropagation")
-			}
-			l.Listener(&Event{Target: jsEvent.Get("target")})
-		}
-	}
+	e.inheritNamespace(parentNamespace)
+	e.propertiesToAttributes()
+	e.checkUnsafeHTML()
+	prevNamespace := parentNamespace
+	parentNamespace = e.childNamespace()
+	defer func() {
+		parentNamespace = prevNamespace
+	}()
 
-	if oldElement, ok := oldComp.(*Element); ok && oldElement.TagName == e.TagName {
+	if oldElement, ok := oldComp.(*Element); ok && e.sameElement(oldElement) {
 		e.node = oldElement.node
 		for name, value := range e.Properties {
-			var oldValue interface{}
-			switch name {
-			case "value":
-				oldValue = e.node.Get("value").String()
-			case "checked":
-				oldValue = e.node.Get("checked").Bool()
-			default:
-				oldValue = oldElement.Properties[name]
-			}
-			if value != oldValue {
-				e.node.Set(name, value)
+			if !e.setAfterChildren(name) {
+				e.reconcileProperty(oldElement, name, value)
 			}
 		}
 		for name := range oldElement.Properties {
 			if _, ok := e.Properties[name]; !ok {
-				e.node.Set(name, nil)
+				dom.SetProperty(e.node, name, nil)
+			}
+		}
+
+		for name, value := range e.Attributes {
+			if value != oldElement.Attributes[name] {
+				dom.SetAttribute(e.node, name, value)
+			}
+		}
+		for name := range oldElement.Attributes {
+			if _, ok := e.Attributes[name]; !ok {
+				dom.RemoveAttribute(e.node, name)
 			}
 		}
 
-		style := e.node.Get("style")
 		for name, value := range e.Style {
-			style.Call("setProperty", name, value)
+			dom.SetStyle(e.node, name, value)
 		}
 		for name := range oldElement.Style {
 			if _, ok := e.Style[name]; !ok {
-				style.Call("removeProperty", name)
+				dom.RemoveStyle(e.node, name)
 			}
 		}
 
-		for _, l := range oldElement.EventListeners {
-			e.node.Call("removeEventListener", l.Name, l.wrapper)
+		for name, value := range e.Dataset {
+			if oldValue, ok := oldElement.Dataset[name]; !ok || value != oldValue {
+				dom.SetData(e.node, name, value)
+			}
 		}
-		for _, l := range e.EventListeners {
-			e.node.Call("addEventListener", l.Name, l.wrapper)
+		for name := range oldElement.Dataset {
+			if _, ok := e.Dataset[name]; !ok {
+				dom.RemoveData(e.node, name)
+			}
 		}
 
-		// TODO better list element reuse
-		for i, newChild := range e.Children {
-			if i >= len(oldElement.Children) {
-				newChild.Reconcile(nil)
-				e.node.Call("appendChild", newChild.Node())
-				continue
+		e.reconcileListeners(oldElement)
+
+		if oldElement.UnsafeHTML != "" && e.UnsafeHTML == "" {
+			dom.SetProperty(e.node, "innerHTML", "")
+		}
+		reconcileChildren(e.Children, oldElement.Children)
+		placeNodes(e.node, childNodes(e.Children), nil)
+		for name, value := range e.Properties {
+			if e.setAfterChildren(name) {
+				e.reconcileProperty(oldElement, name, value)
 			}
-			oldChild := oldElement.Children[i]
-			newChild.Reconcile(oldChild)
-			replaceNode(newChild.Node(), oldChild.Node())
 		}
-		for i := len(e.Children); i < len(oldElement.Children); i++ {
-			removeNode(oldElement.Children[i].Node())
+		if e.UnsafeHTML != oldElement.UnsafeHTML && e.UnsafeHTML != "" {
+			dom.SetProperty(e.node, "innerHTML", e.UnsafeHTML)
 		}
+		e.setRefs(oldElement)
 		return
 	}
 
-	e.node = js.Global.Get("document").Call("createElement", e.TagName)
+	if e.Namespace != "" {
+		e.node = dom.CreateElementNS(e.Namespace, e.TagName)
+	} else {
+		e.node = dom.CreateElement(e.TagName)
+	}
 	for name, value := range e.Properties {
-		e.node.Set(name, value)
+		if !e.setAfterChildren(name) {
+			dom.SetProperty(e.node, name, value)
+		}
+	}
+	for name, value := range e.Attributes {
+		dom.SetAttribute(e.node, name, value)
 	}
 	for name, value := range e.Dataset {
-		e.node.Get("dataset").Set(name, value)
+		dom.SetData(e.node, name, value)
 	}
-	style := e.node.Get("style")
 	for name, value := range e.Style {
-		style.Call("setProperty", name, value)
+		dom.SetStyle(e.node, name, value)
 	}
-	for _, l := range e.EventListeners {
-		e.node.Call("addEventListener", l.Name, l.wrapper)
+	e.reconcileListeners(nil)
+	if e.UnsafeHTML != "" {
+		dom.SetProperty(e.node, "innerHTML", e.UnsafeHTML)
 	}
 	for _, c := range e.Children {
-		c.Reconcile(nil)
-		e.node.Call("appendChild", c.Node())
+		reconcileComponent(c, nil)
+		insertNodes(e.node, componentNodes(c), nil)
+	}
+	for name, value := range e.Properties {
+		if e.setAfterChildren(name) {
+			dom.SetProperty(e.node, name, value)
+		}
+	}
+	e.setRefs(nil)
+}
+
+// liveProperties are the properties which the user can change, such as the
+// value of an input. They are compared with the node instead of the old
+// element when reconciling, so that the node reflects the element even after
+// user input.
+var liveProperties = map[string]bool{
+	"checked":       true,
+	"indeterminate": true,
+	"selected":      true,
+	"selectedIndex": true,
+	"value":         true,
+}
+
+// setAfterChildren reports whether the named property of e can only be set
+// once its children are in place, like the value of a <select>, which selects
+// one of its options.
+func (e *Element) setAfterChildren(name string) bool {
+	return e.TagName == "select" && (name == "value" || name == "selectedIndex")
+}
+
+// reconcileProperty sets the named property of the node of e, which has been
+// taken over from oldElement, to value if it differs.
+func (e *Element) reconcileProperty(oldElement *Element, name string, value interface{}) {
+	if !liveProperties[name] {
+		if value != oldElement.Properties[name] {
+			dom.SetProperty(e.node, name, value)
+		}
+		return
+	}
+	if sameLiveProperty(value, dom.Property(e.node, name)) {
+		// Leave the node alone, e.g. to keep the caret of an input in place.
+		return
+	}
+	if name != "value" {
+		dom.SetProperty(e.node, name, value)
+		return
+	}
+
+	// Setting the value of the focused input moves the caret to the end, so
+	// the selection is restored afterwards. Other inputs are left alone, as
+	// restoring their selection could focus them.
+	if dom.ObjectProperty(dom.Document(), "activeElement") != e.node {
+		dom.SetProperty(e.node, name, value)
+		return
+	}
+	start, hasSelection := dom.Property(e.node, "selectionStart").(float64)
+	end, _ := dom.Property(e.node, "selectionEnd").(float64)
+	dom.SetProperty(e.node, name, value)
+	if hasSelection {
+		dom.SetProperty(e.node, "selectionStart", start)
+		dom.SetProperty(e.node, "selectionEnd", end)
+	}
+}
+
+// sameLiveProperty reports whether value equals the value of a live property
+// read from a node, which is a float64 for numbers.
+func sameLiveProperty(value, live interface{}) bool {
+	if n, ok := value.(int); ok {
+		value = float64(n)
+	}
+	return value == live
+}
+
+// setRefs sets the refs of e to its node once the DOM is complete. The refs of
+// oldElement, whose node e has taken over, which are no longer used are set to
+// nil.
+func (e *Element) setRefs(oldElement *Element) {
+	if len(e.refs) == 0 && (oldElement == nil || len(oldElement.refs) == 0) {
+		return
+	}
+	later(func() {
+		if oldElement != nil {
+			for _, r := range oldElement.refs {
+				if !containsRef(e.refs, r) {
+					r.setNode(nil)
+				}
+			}
+		}
+		for _, r := range e.refs {
+			r.setNode(e.node)
+		}
+	})
+}
+
+// sameElement reports whether e and other represent the same kind of DOM
+// element, so that e can take over the node of other. If e has not been
+// reconciled yet, it is compared with the namespace it is going to inherit.
+func (e *Element) sameElement(other *Element) bool {
+	namespace := e.Namespace
+	if namespace == "" {
+		namespace = parentNamespace
+	}
+	return e.TagName == other.TagName && namespace == other.Namespace
+}
+
+// parentNamespace is the namespace inherited by the elements currently being
+// reconciled, see childNamespace.
+var parentNamespace string
+
+// inheritNamespace sets the namespace of e to the one inherited from its
+// closest ancestor element, unless it has one of its own.
+func (e *Element) inheritNamespace(inherited string) {
+	if e.Namespace == "" {
+		e.Namespace = inherited
+	}
+}
+
+// childNamespace returns the namespace inherited by the descendants of e,
+// which is its own except below an SVG <foreignObject>, whose content is
+// HTML.
+func (e *Element) childNamespace() string {
+	if e.TagName == "foreignObject" {
+		return ""
+	}
+	return e.Namespace
+}
+
+// propertiesToAttributes turns the properties of a namespaced element into
+// attributes, as such elements generally do not reflect their attributes as
+// properties.
+func (e *Element) propertiesToAttributes() {
+	if e.Namespace == "" || len(e.Properties) == 0 {
+		return
+	}
+	if e.Attributes == nil {
+		e.Attributes = make(map[string]interface{})
+	}
+	for name, value := range e.Properties {
+		if attr, ok := attributeNames[name]; ok {
+			name = attr
+		}
+		if _, ok := e.Attributes[name]; ok {
+			panic(fmt.Sprintf("duplicate attribute: %s", name))
+		}
+		e.Attributes[name] = value
+	}
+	e.Properties = nil
+}
+
+// checkUnsafeHTML panics if e has both unsafe HTML and children.
+func (e *Element) checkUnsafeHTML() {
+	if e.UnsafeHTML != "" && len(e.Children) != 0 {
+		panic(fmt.Sprintf("element <%s> has both unsafe HTML and children", e.TagName))
+	}
+}
+
+// listenerKey identifies the DOM event listener of an element for the event
+// listeners of the same name and options.
+type listenerKey struct {
+	name    string
+	options ListenerOptions
+}
+
+// eventHandler is the DOM event listener of an element for one event name and
+// set of options. It dispatches the events to the current EventListeners with
+// that name and options, so that it can stay registered while the element is
+// reconciled.
+type eventHandler struct {
+	listeners []*EventListener
+	remove    func()
+}
+
+// dispatch calls the listeners for the given DOM event, which is handled by
+// the element with the given node, and reports whether one of them stopped its
+// propagation.
+func (h *eventHandler) dispatch(jsEvent, node *js.Object) (stopped bool) {
+	for _, l := range h.listeners {
+		if l.handle(jsEvent, node) {
+			stopped = true
+		}
+	}
+	return stopped
+}
+
+// reconcileListeners makes the event listeners of e receive the events of its
+// node. It takes over the DOM event listeners of oldElement, whose node e has
+// taken over, and only adds and removes DOM event listeners for the event names
+// and options which are new or no longer used. Events are delegated to the
+// delegation root if there is one.
+func (e *Element) reconcileListeners(oldElement *Element) {
+	var oldHandlers map[listenerKey]*eventHandler
+	if oldElement != nil {
+		// oldElement may be e itself, e.g. when an element is reused across
+		// renders, so its handlers are only read.
+		oldHandlers = oldElement.handlers
+	}
+	var handlers map[listenerKey]*eventHandler
+	for _, l := range e.EventListeners {
+		k := listenerKey{name: l.Name, options: l.options}
+		h, ok := handlers[k]
+		if !ok {
+			h, ok = oldHandlers[k]
+			if ok {
+				h.listeners = nil
+			} else {
+				h = &eventHandler{}
+				if delegating != nil && !nonBubblingEvents[l.Name] && l.options == (ListenerOptions{}) {
+					delegating.listen(l.Name)
+				} else {
+					node := e.node
+					h.remove = dom.AddEventListener(node, l.Name, l.options, func(jsEvent *js.Object) {
+						h.dispatch(jsEvent, node)
+					})
+				}
+			}
+			if handlers == nil {
+				handlers = make(map[listenerKey]*eventHandler)
+			}
+			handlers[k] = h
+		}
+		h.listeners = append(h.listeners, l)
+	}
+	for k, h := range oldHandlers {
+		if _, ok := handlers[k]; !ok && h.remove != nil {
+			h.remove()
+		}
+	}
+	e.handlers = handlers
+}
+
+// reconcileChildren reconciles children with oldChildren, the children they
+// replace. Keyed children are matched by key, all others by their index. The
+// nodes of old children which are not taken over are removed, but it is up to
+// the caller to put the nodes of children into place. Children which skip
+// rendering are replaced by their old counterpart in the children slice.
+func reconcileChildren(children, oldChildren []Component) {
+	oldKeyed := make(map[interface{}]int)
+	for i, c := range oldChildren {
+		if key := componentKey(c); key != nil {
+			oldKeyed[key] = i
+		}
+	}
+
+	used := make([]bool, len(oldChildren))
+	matches := make([]Component, len(children))
+	for i, c := range children {
+		j := -1
+		if key := componentKey(c); key != nil {
+			if k, ok := oldKeyed[key]; ok && !used[k] {
+				j = k
+			}
+		} else if i < len(oldChildren) && !used[i] && componentKey(oldChildren[i]) == nil {
+			j = i
+		}
+		if j != -1 {
+			used[j] = true
+			matches[i] = oldChildren[j]
+		}
+	}
+
+	for j, oldChild := range oldChildren {
+		if !used[j] {
+			unmount(oldChild)
+			removeNodes(componentNodes(oldChild))
+		}
+	}
+
+	for i, newChild := range children {
+		oldChild := matches[i]
+		switch {
+		case oldChild == nil:
+			reconcileComponent(newChild, nil)
+			mount(newChild)
+		case !sameComponent(newChild, oldChild):
+			reconcileComponent(newChild, oldChild)
+			unmount(oldChild)
+			removeNodes(componentNodes(oldChild))
+			mount(newChild)
+		case skipRender(newChild, oldChild):
+			// Keep the old child with its body and DOM untouched.
+			children[i] = oldChild
+			if cc, ok := oldChild.(compositeComponent); ok && rendering != nil {
+				cc.composite().parent = rendering
+			}
+		default:
+			reconcileComponent(newChild, oldChild)
+			updated(newChild, oldChild)
+		}
 	}
 }
 
@@ -179,23 +899,71 @@ func (e *Element) Node() *js.Object {
 	return e.node
 }
 
+// componentKey returns the key of c, or nil if c is not keyed.
+func componentKey(c Component) interface{} {
+	if k, ok := c.(Keyer); ok {
+		return k.Key()
+	}
+	return nil
+}
+
 // SetTitle sets the title of the document.
 func SetTitle(title string) {
-	js.Global.Get("document").Set("title", title)
+	dom.SetProperty(dom.Document(), "title", title)
 }
 
 // AddStylesheet adds an external stylesheet to the document.
 func AddStylesheet(url string) {
-	link := js.Global.Get("document").Call("createElement", "link")
-	link.Set("rel", "stylesheet")
-	link.Set("href", url)
-	js.Global.Get("document").Get("head").Call("appendChild", link)
+	link := dom.CreateElement("link")
+	dom.SetProperty(link, "rel", "stylesheet")
+	dom.SetProperty(link, "href", url)
+	dom.InsertBefore(dom.Head(), link, nil)
 }
 
 // Composite is the struct which all components embed.
 type Composite struct {
 	RenderFunc func() Component
 	Body       Component
+
+	// static is set when the composite is rendered to HTML or hydrated, in
+	// which case its body is rendered without being reconciled against the
+	// DOM. The caller sets parent and delegation beforehand.
+	static bool
+
+	// parent is the closest composite whose body contains this one.
+	parent *Composite
+
+	// dirty is set while the composite is scheduled to be rerendered.
+	dirty bool
+
+	// superseded and unmounted are set once the composite is no longer part
+	// of the tree, because it was either replaced by a new composite of the
+	// same type or removed.
+	superseded bool
+	unmounted  bool
+
+	// boundary is set if the composite is the one of an ErrorBoundary,
+	// provider if it is the one of a context provider.
+	boundary *ErrorBoundary
+	provider *provider
+
+	// delegation is the delegation root the composite has been rendered
+	// with, if any, and namespace the namespace its elements inherit.
+	delegation *delegationRoot
+	namespace  string
+}
+
+// rendering is the composite whose body is currently being rendered and
+// reconciled.
+var rendering *Composite
+
+// compositeComponent is implemented by all components embedding Composite.
+type compositeComponent interface {
+	composite() *Composite
+}
+
+func (c *Composite) composite() *Composite {
+	return c
 }
 
 // Node implements the Component interface.
@@ -205,10 +973,129 @@ func (c *Composite) Node() *js.Object {
 
 // ReconcileBody implements the Component interface.
 func (c *Composite) ReconcileBody() {
+	if c.static {
+		c.Body = c.RenderFunc()
+		return
+	}
+
+	c.dirty = false
+	if c.superseded || c.unmounted {
+		// Rendered again after it left the tree, e.g. as a child which its
+		// parent keeps. Its old body has been taken over or unmounted, so
+		// the new one is rendered from scratch.
+		c.superseded = false
+		c.unmounted = false
+		c.Body = nil
+	}
+	if reconcileDepth != 0 {
+		c.delegation = delegating
+		c.namespace = parentNamespace
+	} else {
+		// Rendered on its own, e.g. by Flush.
+		prevDelegating, prevNamespace := delegating, parentNamespace
+		delegating, parentNamespace = c.delegation, c.namespace
+		defer func() {
+			delegating, parentNamespace = prevDelegating, prevNamespace
+		}()
+	}
+	if reconcileDepth == 0 && c.boundary == nil {
+		// A panic while the composite is rendered on its own, e.g. by Flush,
+		// is handled by the closest error boundary above it.
+		if b := c.closestBoundary(); b != nil {
+			reconcile(func() {
+				if !b.catch(c.reconcileBody) {
+					b.reconcileBody()
+				}
+			})
+			return
+		}
+	}
+	reconcile(c.reconcileBody)
+}
+
+func (c *Composite) reconcileBody() {
 	oldBody := c.Body
-	c.Body = c.RenderFunc()
-	c.Body.Reconcile(oldBody)
-	if oldBody != nil {
-		replaceNode(c.Body.Node(), oldBody.Node())
+	if oldBody == nil {
+		c.renderBodyOrFallback(nil)
+		return
+	}
+
+	// Remember where the body is, as its nodes may all be replaced.
+	oldNodes := componentNodes(oldBody)
+	parent := dom.ParentNode(oldNodes[0])
+	ref := dom.NextSibling(oldNodes[len(oldNodes)-1])
+
+	fresh := c.renderBodyOrFallback(oldBody)
+	same := !fresh && sameComponent(c.Body, oldBody)
+	if !same {
+		unmount(oldBody)
+	}
+	if parent != nil {
+		nodes := componentNodes(c.Body)
+		placeNodes(parent, nodes, ref)
+		for _, n := range oldNodes {
+			if !containsNode(nodes, n) {
+				removeNode(n)
+			}
+		}
+	}
+	if same {
+		updated(c.Body, oldBody)
+		return
+	}
+	mount(c.Body)
+}
+
+// renderBodyOrFallback renders the body of c like renderBody. If c is the
+// composite of an ErrorBoundary, a panic while rendering is recovered and the
+// fallback is rendered from scratch instead, in which case it reports true.
+func (c *Composite) renderBodyOrFallback(oldBody Component) (fresh bool) {
+	b := c.boundary
+	if b == nil {
+		c.renderBody(oldBody)
+		return false
+	}
+	if !b.failed && b.catch(func() { c.renderBody(oldBody) }) {
+		return false
+	}
+	c.renderBody(nil)
+	return true
+}
+
+// renderBody renders the body of c and reconciles it against oldBody.
+func (c *Composite) renderBody(oldBody Component) {
+	if rendering != nil {
+		c.parent = rendering
+	}
+	prevRendering := rendering
+	rendering = c
+	defer func() {
+		rendering = prevRendering
+	}()
+
+	// The body is only replaced once it has been reconciled, so that the old
+	// one remains in place if reconciling panics.
+	body := c.RenderFunc()
+	reconcileComponent(body, oldBody)
+	c.Body = body
+}
+
+// closestBoundary returns the closest error boundary above c in the tree, or
+// nil if there is none.
+func (c *Composite) closestBoundary() *ErrorBoundary {
+	for p := c.parent; p != nil; p = p.parent {
+		if p.boundary != nil {
+			return p.boundary
+		}
+	}
+	return nil
+}
+
+// depth returns the number of composites above c in the tree.
+func (c *Composite) depth() int {
+	d := 0
+	for p := c.parent; p != nil; p = p.parent {
+		d++
 	}
+	return d
 }
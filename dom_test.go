@@ -0,0 +1,634 @@
+// +build !js
+
+package vecty_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+	"github.com/gopherjs/vecty/memdom"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/gopherjs/vecty/svg"
+	"github.com/gopherjs/vecty/vectytest"
+)
+
+func TestFragmentMarkup(t *testing.T) {
+	body := memdom.NewDocument().CreateElement("body")
+	tests := []struct {
+		name      string
+		construct func(children ...vecty.Markup) vecty.Component
+	}{
+		{"fragment", vecty.Fragment},
+		{"portal", func(children ...vecty.Markup) vecty.Component {
+			return vecty.Portal(body, children...)
+		}},
+	}
+	for _, tt := range tests {
+		tt.construct(elem.Span(), vecty.List{vecty.Text("a"), nil}, vecty.If(false, vecty.Data("x", "1")))
+
+		for _, m := range []vecty.Markup{
+			vecty.Data("x", "1"),
+			vecty.Key(1),
+			event.Click(nil),
+			vecty.List{elem.Span(), vecty.Style("color", "red")},
+			new(vecty.Ref),
+		} {
+			func() {
+				defer func() {
+					want := "vecty: " + tt.name + " markup must be components"
+					if r := recover(); r != want {
+						t.Errorf("%s with %T: got panic %v, want %q", tt.name, m, r, want)
+					}
+				}()
+				tt.construct(elem.Span(), m)
+			}()
+		}
+	}
+}
+
+func TestReconcileSameElement(t *testing.T) {
+	for _, opts := range [][]vecty.RenderOption{nil, {vecty.DelegateEvents()}} {
+		calls := 0
+		button := elem.Button(event.Click(func(*vecty.MouseEvent) {
+			calls++
+		}))
+		doc := vectytest.Mount(button, opts...)
+		doc.Root().Update(button)
+		doc.Root().Update(button)
+		doc.Click(doc.ByTag("button")[0])
+		doc.Close()
+		if calls != 1 {
+			t.Errorf("got %d calls with %d options, want 1", calls, len(opts))
+		}
+	}
+}
+
+func TestReconcileMarkupOps(t *testing.T) {
+	markup := func(value string, listener bool) vecty.Component {
+		return elem.Div(
+			vecty.Property("id", value),
+			vecty.Style("color", value),
+			vecty.Data("x", value),
+			vecty.Attribute("title", value),
+			vecty.If(listener, event.Click(func(*vecty.MouseEvent) {})),
+		)
+	}
+	doc := vectytest.Mount(markup("a", false))
+	defer doc.Close()
+
+	steps := []struct {
+		name string
+		comp vecty.Component
+		want []string
+	}{
+		// Styles are set on every render.
+		{"unchanged", markup("a", false), []string{
+			`setStyle #2 <div> color a`,
+		}},
+		{"change", markup("b", true), []string{
+			`setProperty #2 <div> id "b"`,
+			`setAttribute #2 <div> title "b"`,
+			`setStyle #2 <div> color b`,
+			`setData #2 <div> x "b"`,
+			`addEventListener #2 <div> click`,
+		}},
+		{"change listener", markup("b", true), []string{
+			`setStyle #2 <div> color b`,
+		}},
+		{"remove", elem.Div(), []string{
+			`setProperty #2 <div> id <nil>`,
+			`removeAttribute #2 <div> title`,
+			`removeStyle #2 <div> color`,
+			`removeData #2 <div> x`,
+			`removeEventListener #2 <div> click`,
+		}},
+		{"add", markup("c", true), []string{
+			`setProperty #2 <div> id "c"`,
+			`setAttribute #2 <div> title "c"`,
+			`setStyle #2 <div> color c`,
+			`setData #2 <div> x "c"`,
+			`addEventListener #2 <div> click`,
+		}},
+	}
+	for _, s := range steps {
+		doc.ResetOps()
+		doc.Root().Update(s.comp)
+		if got := doc.Ops(); !reflect.DeepEqual(got, s.want) {
+			t.Errorf("%s: got ops\n%q\nwant\n%q", s.name, got, s.want)
+		}
+	}
+}
+
+func TestReconcileKeyedOps(t *testing.T) {
+	list := func(keys ...string) vecty.Component {
+		var items vecty.List
+		for _, k := range keys {
+			items = append(items, elem.ListItem(vecty.Key(k), vecty.Text(k)))
+		}
+		return elem.UnorderedList(items)
+	}
+	// The items a, b and c are the nodes #3, #5 and #7.
+	doc := vectytest.Mount(list("a", "b", "c"))
+	defer doc.Close()
+
+	steps := []struct {
+		keys []string
+		want []string
+	}{
+		{[]string{"c", "a", "b"}, []string{
+			`insertBefore #2 <ul> #5 <li> <nil>`,
+			`insertBefore #2 <ul> #3 <li> #5 <li>`,
+		}},
+		{[]string{"b", "c"}, []string{
+			`removeChild #2 <ul> #3 <li>`,
+			`insertBefore #2 <ul> #7 <li> <nil>`,
+		}},
+		{[]string{"d", "b", "c"}, []string{
+			`createElement #9 <li>`,
+			`createTextNode #10 "d"`,
+			`insertBefore #9 <li> #10 "d" <nil>`,
+			`insertBefore #2 <ul> #9 <li> #5 <li>`,
+		}},
+	}
+	for _, s := range steps {
+		doc.ResetOps()
+		doc.Root().Update(list(s.keys...))
+		if got := doc.Ops(); !reflect.DeepEqual(got, s.want) {
+			t.Errorf("%q: got ops\n%q\nwant\n%q", s.keys, got, s.want)
+		}
+		var texts []string
+		for _, n := range doc.ByTag("li") {
+			texts = append(texts, n.Text())
+		}
+		if !reflect.DeepEqual(texts, s.keys) {
+			t.Errorf("got items %q, want %q", texts, s.keys)
+		}
+	}
+}
+
+// logged is a composite which logs its lifecycle methods, and panics while
+// rendering if fail is set.
+type logged struct {
+	vecty.Composite
+
+	name     string
+	log      *[]string
+	fail     bool
+	children []vecty.Markup
+}
+
+func (l *logged) Apply(element *vecty.Element) {
+	element.AddChild(l)
+}
+
+func (l *logged) Reconcile(oldComp vecty.Component) {
+	if oldComp, ok := oldComp.(*logged); ok {
+		l.Body = oldComp.Body
+	}
+	l.RenderFunc = func() vecty.Component {
+		if l.fail {
+			panic("render failed")
+		}
+		return elem.Span(vecty.Text(l.name), vecty.List(l.children))
+	}
+	l.ReconcileBody()
+}
+
+func (l *logged) Mount() {
+	*l.log = append(*l.log, "mount "+l.name)
+}
+
+func (l *logged) Unmount() {
+	*l.log = append(*l.log, "unmount "+l.name)
+}
+
+func (l *logged) Updated(oldComp vecty.Component) {
+	*l.log = append(*l.log, "updated "+l.name)
+}
+
+func TestReconcilePanicDiscardsLifecycle(t *testing.T) {
+	var log []string
+	h := &holder{children: []vecty.Markup{elem.Span()}}
+	doc := vectytest.Mount(h)
+	defer doc.Close()
+
+	h.children = []vecty.Markup{
+		&logged{name: "A", log: &log},
+		&logged{name: "B", log: &log, fail: true},
+	}
+	vecty.Rerender(h)
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Flush did not panic")
+			}
+		}()
+		vecty.Flush()
+	}()
+
+	h.children = []vecty.Markup{elem.Span()}
+	vecty.Rerender(h)
+	vecty.Flush()
+	if len(log) != 0 {
+		t.Errorf("got %q after the panic, want nothing", log)
+	}
+}
+
+func TestRootUpdate(t *testing.T) {
+	doc := vectytest.Mount(elem.Div(vecty.Text("a")))
+	defer doc.Close()
+	root := doc.Root()
+	if got := doc.Node(root.Container()); got != doc.Container() {
+		t.Errorf("got container %v, want %v", got, doc.Container())
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Update did not panic")
+			}
+		}()
+		root.Update(elem.Div(&panicky{fail: true}))
+	}()
+
+	var log []string
+	root.Update(elem.Div(&logged{name: "b", log: &log}))
+	if got := doc.Container().Text(); got != "b" {
+		t.Errorf("got text %q after updating the root, want %q", got, "b")
+	}
+	root.Unmount()
+	if got := doc.Container().Children; len(got) != 0 {
+		t.Errorf("got %v in the container after Unmount, want nothing", got)
+	}
+	if want := []string{"mount b", "unmount b"}; !reflect.DeepEqual(log, want) {
+		t.Errorf("got %q, want %q", log, want)
+	}
+}
+
+func TestReconcileLiveProperties(t *testing.T) {
+	doc := vectytest.Mount(elem.Div(
+		elem.Input(prop.Value("a")),
+		elem.Input(prop.Type(prop.TypeCheckbox), prop.Checked(false)),
+	))
+	defer doc.Close()
+	inputs := doc.ByTag("input")
+
+	// The user input is reverted, although the element has not changed.
+	doc.Input(inputs[0], "ab")
+	doc.Check(inputs[1], true)
+	doc.ResetOps()
+	doc.Root().Update(elem.Div(
+		elem.Input(prop.Value("a")),
+		elem.Input(prop.Type(prop.TypeCheckbox), prop.Checked(false)),
+	))
+	want := []string{
+		`setProperty #3 <input> value "a"`,
+		`setProperty #4 <input> checked false`,
+	}
+	if got := doc.Ops(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got ops\n%q\nwant\n%q", got, want)
+	}
+
+	// A node which already has the value is left alone, although the element
+	// has changed.
+	doc.Input(inputs[0], "abc")
+	doc.ResetOps()
+	doc.Root().Update(elem.Div(
+		elem.Input(prop.Value("abc")),
+		elem.Input(prop.Type(prop.TypeCheckbox), prop.Checked(false)),
+	))
+	if got := doc.Ops(); len(got) != 0 {
+		t.Errorf("got ops %q, want none", got)
+	}
+}
+
+func TestReconcileSelectValue(t *testing.T) {
+	options := func(values ...string) vecty.List {
+		var l vecty.List
+		for _, v := range values {
+			l = append(l, elem.Option(vecty.Key(v), prop.Value(v)))
+		}
+		return l
+	}
+	doc := vectytest.Mount(elem.Select(prop.Value("b"), options("a", "b")))
+	defer doc.Close()
+	// The value is set once the option it selects has been inserted.
+	want := []string{
+		`createElement #1 <body>`,
+		`createElement #2 <select>`,
+		`createElement #3 <option>`,
+		`setProperty #3 <option> value "a"`,
+		`insertBefore #2 <select> #3 <option> <nil>`,
+		`createElement #4 <option>`,
+		`setProperty #4 <option> value "b"`,
+		`insertBefore #2 <select> #4 <option> <nil>`,
+		`setProperty #2 <select> value "b"`,
+		`insertBefore #1 <body> #2 <select> <nil>`,
+	}
+	if got := doc.Ops(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got ops\n%q\nwant\n%q", got, want)
+	}
+
+	doc.ResetOps()
+	doc.Root().Update(elem.Select(prop.Value("c"), options("a", "b", "c")))
+	want = []string{
+		`createElement #5 <option>`,
+		`setProperty #5 <option> value "c"`,
+		`insertBefore #2 <select> #5 <option> <nil>`,
+		`setProperty #2 <select> value "c"`,
+	}
+	if got := doc.Ops(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got ops\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestReconcileValueSelection(t *testing.T) {
+	doc := vectytest.Mount(elem.Div(elem.Input(prop.Value("")), elem.Input(prop.Value(""))))
+	defer doc.Close()
+	inputs := doc.ByTag("input")
+	for _, n := range inputs {
+		doc.Input(n, "abc")
+		n.Properties["selectionStart"] = 1.0
+		n.Properties["selectionEnd"] = 2.0
+	}
+	doc.Focus(inputs[0])
+
+	// Only the selection of the focused input is restored.
+	doc.ResetOps()
+	doc.Root().Update(elem.Div(elem.Input(prop.Value("ABC")), elem.Input(prop.Value("ABC"))))
+	want := []string{
+		`setProperty #3 <input> value "ABC"`,
+		`setProperty #3 <input> selectionStart 1`,
+		`setProperty #3 <input> selectionEnd 2`,
+		`setProperty #4 <input> value "ABC"`,
+	}
+	if got := doc.Ops(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got ops\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestLifecycle(t *testing.T) {
+	var log []string
+	tree := func(children ...string) *logged {
+		var markup []vecty.Markup
+		for _, name := range children {
+			markup = append(markup, &logged{name: name, log: &log})
+		}
+		return &logged{name: "A", log: &log, children: markup}
+	}
+	first := tree("B", "C")
+	doc := vectytest.Mount(first)
+	defer doc.Close()
+	root := doc.Root()
+	if want := []string{"mount B", "mount C", "mount A"}; !reflect.DeepEqual(log, want) {
+		t.Errorf("got %q, want %q", log, want)
+	}
+
+	steps := []struct {
+		name   string
+		update func()
+		want   []string
+	}{
+		{"update", func() { root.Update(tree("B", "C")) }, []string{"updated B", "updated C", "updated A"}},
+		{"remove child", func() { root.Update(tree("B")) }, []string{"unmount C", "updated B", "updated A"}},
+		{"remove subtree", func() { root.Update(elem.Div()) }, []string{"unmount A", "unmount B"}},
+		{"mount again", func() { root.Update(first) }, []string{"mount B", "mount C", "mount A"}},
+		{"unmount", root.Unmount, []string{"unmount A", "unmount B", "unmount C"}},
+	}
+	for _, s := range steps {
+		log = nil
+		s.update()
+		if !reflect.DeepEqual(log, s.want) {
+			t.Errorf("%s: got %q, want %q", s.name, log, s.want)
+		}
+	}
+}
+
+func TestReconcileUnsafeHTML(t *testing.T) {
+	doc := vectytest.Mount(elem.Div(vecty.UnsafeHTML("<b>a</b>")))
+	defer doc.Close()
+
+	steps := []struct {
+		name string
+		comp vecty.Component
+		want []string
+	}{
+		{"unchanged", elem.Div(vecty.UnsafeHTML("<b>a</b>")), nil},
+		{"change", elem.Div(vecty.UnsafeHTML("<b>b</b>")), []string{
+			`setProperty #2 <div> innerHTML "<b>b</b>"`,
+		}},
+		{"to children", elem.Div(vecty.Text("c")), []string{
+			`setProperty #2 <div> innerHTML ""`,
+			`createTextNode #3 "c"`,
+			`insertBefore #2 <div> #3 "c" <nil>`,
+		}},
+		{"from children", elem.Div(vecty.UnsafeHTML("<b>d</b>")), []string{
+			`removeChild #2 <div> #3 "c"`,
+			`setProperty #2 <div> innerHTML "<b>d</b>"`,
+		}},
+	}
+	for _, s := range steps {
+		doc.ResetOps()
+		doc.Root().Update(s.comp)
+		if got := doc.Ops(); !reflect.DeepEqual(got, s.want) {
+			t.Errorf("%s: got ops\n%q\nwant\n%q", s.name, got, s.want)
+		}
+	}
+}
+
+// rendered is a composite which renders the component returned by render.
+type rendered struct {
+	vecty.Composite
+
+	render func() vecty.Component
+}
+
+func (r *rendered) Apply(element *vecty.Element) {
+	element.AddChild(r)
+}
+
+func (r *rendered) Reconcile(oldComp vecty.Component) {
+	if oldComp, ok := oldComp.(*rendered); ok {
+		r.Body = oldComp.Body
+	}
+	r.RenderFunc = r.render
+	r.ReconcileBody()
+}
+
+func TestNamespaces(t *testing.T) {
+	// shape returns an element without a namespace, which it inherits.
+	shape := func(tagName string, markup ...vecty.Markup) *vecty.Element {
+		e := &vecty.Element{TagName: tagName}
+		vecty.List(markup).Apply(e)
+		return e
+	}
+	class := "a"
+	path := &rendered{render: func() vecty.Component {
+		return shape("path", vecty.Property("className", class))
+	}}
+	doc := vectytest.Mount(svg.SVG(
+		vecty.Property("viewBox", "0 0 1 1"),
+		shape("rect"),
+		vecty.Fragment(shape("line")),
+		path,
+		svg.ForeignObject(elem.Div(prop.ID("html"))),
+	))
+	defer doc.Close()
+
+	want := []string{
+		`createElement #1 <body>`,
+		`createElementNS #2 <svg> http://www.w3.org/2000/svg`,
+		`setAttribute #2 <svg> viewBox "0 0 1 1"`,
+		`createElementNS #3 <rect> http://www.w3.org/2000/svg`,
+		`insertBefore #2 <svg> #3 <rect> <nil>`,
+		`createElementNS #4 <line> http://www.w3.org/2000/svg`,
+		`insertBefore #2 <svg> #4 <line> <nil>`,
+		`createElementNS #5 <path> http://www.w3.org/2000/svg`,
+		`setAttribute #5 <path> class "a"`,
+		`insertBefore #2 <svg> #5 <path> <nil>`,
+		`createElementNS #6 <foreignObject> http://www.w3.org/2000/svg`,
+		`createElement #7 <div>`,
+		`setProperty #7 <div> id "html"`,
+		`insertBefore #6 <foreignObject> #7 <div> <nil>`,
+		`insertBefore #2 <svg> #6 <foreignObject> <nil>`,
+		`insertBefore #1 <body> #2 <svg> <nil>`,
+	}
+	if got := doc.Ops(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got ops\n%q\nwant\n%q", got, want)
+	}
+
+	// A composite rendered on its own keeps the namespace of its elements.
+	doc.ResetOps()
+	class = "b"
+	vecty.Rerender(path)
+	vecty.Flush()
+	want = []string{`setAttribute #5 <path> class "b"`}
+	if got := doc.Ops(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got ops\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestReconcileListenerOptions(t *testing.T) {
+	var calls []string
+	button := func(capture, once bool) vecty.Component {
+		listener := func(name string) func(*vecty.MouseEvent) {
+			return func(*vecty.MouseEvent) {
+				calls = append(calls, name)
+			}
+		}
+		return elem.Button(
+			event.Click(listener("plain")),
+			event.Click(listener("passive")).Passive(),
+			event.Click(listener("plain again")),
+			vecty.If(capture, event.Click(listener("capture")).Capture()),
+			vecty.If(once, event.Click(listener("once")).Once()),
+		)
+	}
+	doc := vectytest.Mount(button(true, true))
+	defer doc.Close()
+	n := doc.ByTag("button")[0]
+
+	// There is one DOM event listener for each set of options.
+	want := []string{
+		`createElement #1 <body>`,
+		`createElement #2 <button>`,
+		`addEventListener #2 <button> click`,
+		`addEventListener #2 <button> click passive`,
+		`addEventListener #2 <button> click capture`,
+		`addEventListener #2 <button> click once`,
+		`insertBefore #1 <body> #2 <button> <nil>`,
+	}
+	if got := doc.Ops(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got ops\n%q\nwant\n%q", got, want)
+	}
+	doc.Click(n)
+	if want := []string{"capture", "plain", "plain again", "passive", "once"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("got calls %q, want %q", calls, want)
+	}
+
+	steps := []struct {
+		name           string
+		capture, once  bool
+		wantOps, calls []string
+	}{
+		{"once consumed", true, true, nil, []string{"capture", "plain", "plain again", "passive"}},
+		{"remove capture", false, true, []string{
+			`removeEventListener #2 <button> click capture`,
+		}, []string{"plain", "plain again", "passive"}},
+		{"remove once", false, false, nil, []string{"plain", "plain again", "passive"}},
+	}
+	for _, s := range steps {
+		calls = nil
+		doc.ResetOps()
+		doc.Root().Update(button(s.capture, s.once))
+		if got := doc.Ops(); !reflect.DeepEqual(got, s.wantOps) {
+			t.Errorf("%s: got ops\n%q\nwant\n%q", s.name, got, s.wantOps)
+		}
+		doc.Click(n)
+		if !reflect.DeepEqual(calls, s.calls) {
+			t.Errorf("%s: got calls %q, want %q", s.name, calls, s.calls)
+		}
+	}
+}
+
+func TestRefs(t *testing.T) {
+	ref := new(vecty.Ref)
+	doc := vectytest.Mount(elem.Div(ref))
+	defer doc.Close()
+	div := doc.ByTag("div")[0]
+	if doc.Node(ref.Node()) != div {
+		t.Errorf("got node %v, want the <div>", ref.Node())
+	}
+	doc.Root().Update(elem.Div(ref))
+	if doc.Node(ref.Node()) != div {
+		t.Errorf("got node %v after rendering again, want the <div>", ref.Node())
+	}
+	doc.Root().Update(elem.Div())
+	if ref.Node() != nil {
+		t.Errorf("got node %v without the markup, want nil", ref.Node())
+	}
+	doc.Root().Update(elem.Div(ref))
+	doc.Root().Update(elem.Span())
+	if ref.Node() != nil {
+		t.Errorf("got node %v after removing the element, want nil", ref.Node())
+	}
+}
+
+func TestRefFunc(t *testing.T) {
+	var calls []string
+	refFunc := func(name string) vecty.Markup {
+		return vecty.RefFunc(func(node *js.Object) {
+			if node == nil {
+				calls = append(calls, name+" nil")
+				return
+			}
+			calls = append(calls, name+" node")
+		})
+	}
+	kept := refFunc("kept")
+	doc := vectytest.Mount(elem.Div(kept))
+	defer doc.Close()
+	root := doc.Root()
+
+	steps := []struct {
+		name   string
+		update func()
+		want   []string
+	}{
+		{"kept", func() { root.Update(elem.Div(kept)) }, []string{"kept node"}},
+		{"new", func() { root.Update(elem.Div(refFunc("new"))) }, []string{"kept nil", "new node"}},
+		{"new again", func() { root.Update(elem.Div(refFunc("again"))) }, []string{"new nil", "again node"}},
+		{"remove", func() { root.Update(elem.Span()) }, []string{"again nil"}},
+	}
+	for _, s := range steps {
+		calls = nil
+		s.update()
+		if !reflect.DeepEqual(calls, s.want) {
+			t.Errorf("%s: got calls %q, want %q", s.name, calls, s.want)
+		}
+	}
+}
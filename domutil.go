@@ -2,13 +2,212 @@ package vecty
 
 import "github.com/gopherjs/gopherjs/js"
 
+// DOM is the interface through which Vecty manipulates the document. By
+// default the browser's DOM is used through GopherJS. SetDOM replaces it, e.g.
+// by the in-memory implementation of package memdom, which allows running the
+// reconciler under the standard Go toolchain.
+//
+// Nodes and events are represented by handles of type *js.Object. A handle only
+// needs to be meaningful to the implementation which created it.
+type DOM interface {
+	// Document returns the document node, e.g. to read its readyState
+	// property or to listen to its events.
+	Document() *js.Object
+
+	// Head returns the head element of the document.
+	Head() *js.Object
+
+	// SetBody replaces the body element of the document by body.
+	SetBody(body *js.Object)
+
+	// CreateElement creates an element with the given tag name.
+	CreateElement(tagName string) *js.Object
+
+	// CreateElementNS creates an element with the given tag name in the given
+	// namespace.
+	CreateElementNS(namespace, tagName string) *js.Object
+
+	// CreateTextNode creates a text node with the given text.
+	CreateTextNode(text string) *js.Object
+
+	// NodeName returns the name of the node, i.e. the tag name of an
+	// element, which is uppercase for HTML elements, or "#text" for a text
+	// node.
+	NodeName(node *js.Object) string
+
+	// NodeValue returns the text of a text node.
+	NodeValue(node *js.Object) string
+
+	// SetNodeValue sets the text of a text node.
+	SetNodeValue(node *js.Object, text string)
+
+	// Property returns the value of the named property of node, or of an
+	// event, which must be a string, number or boolean.
+	Property(node *js.Object, name string) interface{}
+
+	// ObjectProperty returns the value of the named property of node, or of
+	// an event, which is an object such as another node, or nil if it is not
+	// set.
+	ObjectProperty(node *js.Object, name string) *js.Object
+
+	// SetProperty sets the named property of node.
+	SetProperty(node *js.Object, name string, value interface{})
+
+	// Attribute returns the value of the named attribute of node, and whether
+	// node has the attribute.
+	Attribute(node *js.Object, name string) (value string, ok bool)
+
+	// SetAttribute sets the named attribute of node.
+	SetAttribute(node *js.Object, name string, value interface{})
+
+	// RemoveAttribute removes the named attribute of node.
+	RemoveAttribute(node *js.Object, name string)
+
+	// Style returns the value of the named style property of node, or "" if
+	// it is not set.
+	Style(node *js.Object, name string) string
+
+	// SetStyle sets the named style property of node.
+	SetStyle(node *js.Object, name string, value interface{})
+
+	// RemoveStyle removes the named style property of node.
+	RemoveStyle(node *js.Object, name string)
+
+	// Data returns the value of the named custom data attribute of node, and
+	// whether node has the attribute.
+	Data(node *js.Object, name string) (value string, ok bool)
+
+	// SetData sets the named custom data attribute of node.
+	SetData(node *js.Object, name, value string)
+
+	// RemoveData removes the named custom data attribute of node.
+	RemoveData(node *js.Object, name string)
+
+	// AddEventListener registers listener for the named event on node with
+	// the given options. The returned function unregisters it again.
+	AddEventListener(node *js.Object, name string, options ListenerOptions, listener func(event *js.Object)) (remove func())
+
+	// InsertBefore inserts node as a child of parent before ref, or as the
+	// last child if ref is nil. If node already is in the document, it is
+	// moved.
+	InsertBefore(parent, node, ref *js.Object)
+
+	// ReplaceChild replaces oldChild of parent by newChild.
+	ReplaceChild(parent, newChild, oldChild *js.Object)
+
+	// RemoveChild removes child from parent.
+	RemoveChild(parent, child *js.Object)
+
+	// ParentNode returns the parent of node, or nil.
+	ParentNode(node *js.Object) *js.Object
+
+	// FirstChild returns the first child of node, or nil.
+	FirstChild(node *js.Object) *js.Object
+
+	// NextSibling returns the node following node, or nil.
+	NextSibling(node *js.Object) *js.Object
+
+	// EventTarget returns the node which an event was dispatched to.
+	EventTarget(event *js.Object) *js.Object
+
+	// PreventDefault prevents the default action of an event.
+	PreventDefault(event *js.Object)
+
+	// StopPropagation stops the propagation of an event.
+	StopPropagation(event *js.Object)
+
+	// RequestAnimationFrame schedules callback to be called before the next
+	// repaint.
+	RequestAnimationFrame(callback func())
+
+	// Warn reports a problem to the developer, e.g. on the browser console.
+	Warn(message string)
+}
+
+// ListenerOptions are the options of a DOM event listener.
+type ListenerOptions struct {
+	Capture bool
+	Passive bool
+	Once    bool
+}
+
+var dom DOM = jsDOM{}
+
+// SetDOM sets the DOM implementation used by Vecty and returns the previous
+// one. It must not be called while components are rendered. Composites
+// scheduled by Rerender are unscheduled, as they belong to the previous DOM.
+func SetDOM(d DOM) DOM {
+	prev := dom
+	dom = d
+	resetScheduler()
+	return prev
+}
+
 func removeNode(node *js.Object) {
-	node.Get("parentNode").Call("removeChild", node)
+	parent := dom.ParentNode(node)
+	if parent == nil {
+		return // already detached, e.g. replaced by a composite
+	}
+	dom.RemoveChild(parent, node)
+}
+
+func removeNodes(nodes []*js.Object) {
+	for _, n := range nodes {
+		removeNode(n)
+	}
+}
+
+// insertNodes inserts nodes into parent before ref, or at the end if ref is
+// nil.
+func insertNodes(parent *js.Object, nodes []*js.Object, ref *js.Object) {
+	for _, n := range nodes {
+		dom.InsertBefore(parent, n, ref)
+	}
+}
+
+// placeNodes makes sure that nodes are the children of parent right before ref,
+// or at the end if ref is nil, moving only the nodes which are out of place.
+func placeNodes(parent *js.Object, nodes []*js.Object, ref *js.Object) {
+	// Work back to front, so that every node only has to be checked against
+	// its successor.
+	next := ref
+	for i := len(nodes) - 1; i >= 0; i-- {
+		n := nodes[i]
+		if dom.ParentNode(n) != parent || dom.NextSibling(n) != next {
+			dom.InsertBefore(parent, n, next)
+		}
+		next = n
+	}
+}
+
+// componentNodes returns the DOM nodes of c, which are more than one if c is
+// or renders a fragment.
+func componentNodes(c Component) []*js.Object {
+	switch c := c.(type) {
+	case *fragment:
+		return c.nodes()
+	case compositeComponent:
+		if body := c.composite().Body; body != nil {
+			return componentNodes(body)
+		}
+	}
+	return []*js.Object{c.Node()}
+}
+
+// childNodes returns the DOM nodes of all children.
+func childNodes(children []Component) []*js.Object {
+	var nodes []*js.Object
+	for _, c := range children {
+		nodes = append(nodes, componentNodes(c)...)
+	}
+	return nodes
 }
 
-func replaceNode(newNode, oldNode *js.Object) {
-	if newNode == oldNode {
-		return
+func containsNode(nodes []*js.Object, node *js.Object) bool {
+	for _, n := range nodes {
+		if n == node {
+			return true
+		}
 	}
-	oldNode.Get("parentNode").Call("replaceChild", newNode, oldNode)
+	return false
 }
@@ -3,6 +3,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"io"
 	"os"
@@ -81,7 +82,25 @@ var elemNameMap = map[string]string{
 	"wbr":        "WordBreakOpportunity",
 }
 
+// svgNameMap translates SVG tag names from the MDN source into a proper Go
+// style name, like elemNameMap. Names starting with "fe" are translated to
+// "FE..." by svgFuncName.
+var svgNameMap = map[string]string{
+	"a":     "Anchor",
+	"g":     "Group",
+	"svg":   "SVG",
+	"tspan": "TSpan",
+}
+
+var svg = flag.Bool("svg", false, "generate svg.gen.go with the SVG elements instead of elem.gen.go")
+
 func main() {
+	flag.Parse()
+	if *svg {
+		generateSVG()
+		return
+	}
+
 	doc, err := goquery.NewDocument("https://developer.mozilla.org/en-US/docs/Web/HTML/Element")
 	if err != nil {
 		panic(err)
@@ -173,6 +192,92 @@ func %s(markup ...vecty.Markup) *vecty.Element {
 `, descToComments(desc), link, funName, name)
 }
 
+func generateSVG() {
+	doc, err := goquery.NewDocument("https://developer.mozilla.org/en-US/docs/Web/SVG/Element")
+	if err != nil {
+		panic(err)
+	}
+
+	file, err := os.Create("svg.gen.go")
+	if err != nil {
+		panic(err)
+	}
+	defer file.Close()
+
+	fmt.Fprint(file, `//go:generate go run ../elem/generate.go -svg
+
+// Package svg defines markup to create SVG elements. The elements are created
+// in the SVG namespace, which is inherited by their child elements.
+//
+// Generated from "SVG element reference" by Mozilla Contributors,
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element, licensed under
+// CC-BY-SA 2.5.
+package svg
+
+import "github.com/gopherjs/vecty"
+`)
+
+	seen := make(map[string]bool)
+	doc.Find(".quick-links a").Each(func(i int, s *goquery.Selection) {
+		link, _ := s.Attr("href")
+		if !strings.HasPrefix(link, "/en-US/docs/Web/SVG/Element/") {
+			return
+		}
+
+		if s.Parent().Find(".icon-trash, .icon-thumbs-down-alt, .icon-warning-sign, .icon-beaker").Length() > 0 {
+			return
+		}
+
+		text := s.Text()
+		if !strings.HasPrefix(text, "<") {
+			return
+		}
+		name := text[1 : len(text)-1]
+		if seen[name] {
+			return
+		}
+		seen[name] = true
+
+		desc, _ := s.Attr("title")
+		writeSVGElem(file, name, desc, link)
+	})
+}
+
+func writeSVGElem(w io.Writer, name, desc, link string) {
+	funName := svgFuncName(name)
+
+	// Descriptions for SVG elements generally read as:
+	//
+	//  The <foobar> SVG element ...
+	//
+	// which we reword like the HTML ones.
+	generalLowercase := fmt.Sprintf("the <%s> svg element", strings.ToLower(name))
+	desc = strings.Replace(desc, "\u00a0", " ", -1)
+	if l := len(generalLowercase); len(desc) > l && strings.HasPrefix(strings.ToLower(desc), generalLowercase) {
+		desc = fmt.Sprintf("%s%s", funName, desc[l:])
+	}
+
+	fmt.Fprintf(w, `%s
+//
+// https://developer.mozilla.org%s
+func %s(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "%s", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+`, descToComments(desc), link, funName, name)
+}
+
+func svgFuncName(name string) string {
+	if funName, ok := svgNameMap[name]; ok {
+		return funName
+	}
+	if strings.HasPrefix(name, "fe") {
+		return "FE" + name[2:]
+	}
+	return capitalize(name)
+}
+
 func capitalize(s string) string {
 	return strings.ToUpper(s[:1]) + s[1:]
 }
@@ -103,8 +103,10 @@ func Blocked(listener func(*vecty.Event)) *vecty.EventListener {
 // Blur is an event fired when an element has lost focus (does not bubble).
 //
 // https://developer.mozilla.org/docs/Web/Events/blur
-func Blur(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "blur", Listener: listener}
+func Blur(listener func(*vecty.FocusEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "blur", Listener: func(e *vecty.Event) {
+		listener(&vecty.FocusEvent{Event: e})
+	}}
 }
 
 // Boundary is an event fired when the spoken utterance reaches a word or
@@ -177,8 +179,10 @@ func Checking(listener func(*vecty.Event)) *vecty.EventListener {
 // released on an element.
 //
 // https://developer.mozilla.org/docs/Web/Events/click
-func Click(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "click", Listener: listener}
+func Click(listener func(*vecty.MouseEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "click", Listener: func(e *vecty.Event) {
+		listener(&vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // Close is an event fired when a WebSocket connection has been closed.
@@ -225,8 +229,10 @@ func CompositionUpdate(listener func(*vecty.Event)) *vecty.EventListener {
 // (before the context menu is displayed).
 //
 // https://developer.mozilla.org/docs/Web/Events/contextmenu
-func ContextMenu(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "contextmenu", Listener: listener}
+func ContextMenu(listener func(*vecty.MouseEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "contextmenu", Listener: func(e *vecty.Event) {
+		listener(&vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // Copy is an event fired when the text selection has been added to the
@@ -298,8 +304,10 @@ func DischargingTimeChange(listener func(*vecty.Event)) *vecty.EventListener {
 // on an element.
 //
 // https://developer.mozilla.org/docs/Web/Events/dblclick
-func DoubleClick(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "dblclick", Listener: listener}
+func DoubleClick(listener func(*vecty.MouseEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "dblclick", Listener: func(e *vecty.Event) {
+		listener(&vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // Downloading is an event fired when the user agent has found an update and is
@@ -315,55 +323,69 @@ func Downloading(listener func(*vecty.Event)) *vecty.EventListener {
 // (every 350ms).
 //
 // https://developer.mozilla.org/docs/Web/Events/drag
-func Drag(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "drag", Listener: listener}
+func Drag(listener func(*vecty.DragEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "drag", Listener: func(e *vecty.Event) {
+		listener(&vecty.DragEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
 
 // DragEnd is an event fired when a drag operation is being ended (by releasing
 // a mouse button or hitting the escape key).
 //
 // https://developer.mozilla.org/docs/Web/Events/dragend
-func DragEnd(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "dragend", Listener: listener}
+func DragEnd(listener func(*vecty.DragEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "dragend", Listener: func(e *vecty.Event) {
+		listener(&vecty.DragEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
 
 // DragEnter is an event fired when a dragged element or text selection enters
 // a valid drop target.
 //
 // https://developer.mozilla.org/docs/Web/Events/dragenter
-func DragEnter(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "dragenter", Listener: listener}
+func DragEnter(listener func(*vecty.DragEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "dragenter", Listener: func(e *vecty.Event) {
+		listener(&vecty.DragEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
 
 // DragLeave is an event fired when a dragged element or text selection leaves
 // a valid drop target.
 //
 // https://developer.mozilla.org/docs/Web/Events/dragleave
-func DragLeave(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "dragleave", Listener: listener}
+func DragLeave(listener func(*vecty.DragEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "dragleave", Listener: func(e *vecty.Event) {
+		listener(&vecty.DragEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
 
 // DragOver is an event fired when an element or text selection is being
 // dragged over a valid drop target (every 350ms).
 //
 // https://developer.mozilla.org/docs/Web/Events/dragover
-func DragOver(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "dragover", Listener: listener}
+func DragOver(listener func(*vecty.DragEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "dragover", Listener: func(e *vecty.Event) {
+		listener(&vecty.DragEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
 
 // DragStart is an event fired when the user starts dragging an element or text
 // selection.
 //
 // https://developer.mozilla.org/docs/Web/Events/dragstart
-func DragStart(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "dragstart", Listener: listener}
+func DragStart(listener func(*vecty.DragEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "dragstart", Listener: func(e *vecty.Event) {
+		listener(&vecty.DragEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
 
 // Drop is an event fired when an element is dropped on a valid drop target.
 //
 // https://developer.mozilla.org/docs/Web/Events/drop
-func Drop(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "drop", Listener: listener}
+func Drop(listener func(*vecty.DragEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "drop", Listener: func(e *vecty.Event) {
+		listener(&vecty.DragEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
 
 // DurationChange is an event fired when the duration attribute has been
@@ -417,23 +439,29 @@ func Error(listener func(*vecty.Event)) *vecty.EventListener {
 // bubble).
 //
 // https://developer.mozilla.org/docs/Web/Events/focus
-func Focus(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "focus", Listener: listener}
+func Focus(listener func(*vecty.FocusEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "focus", Listener: func(e *vecty.Event) {
+		listener(&vecty.FocusEvent{Event: e})
+	}}
 }
 
 // FocusIn is an event fired when an element is about to receive focus
 // (bubbles).
 //
 // https://developer.mozilla.org/docs/Web/Events/focusin
-func FocusIn(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "focusin", Listener: listener}
+func FocusIn(listener func(*vecty.FocusEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "focusin", Listener: func(e *vecty.Event) {
+		listener(&vecty.FocusEvent{Event: e})
+	}}
 }
 
 // FocusOut is an event fired when an element is about to lose focus (bubbles).
 //
 // https://developer.mozilla.org/docs/Web/Events/focusout
-func FocusOut(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "focusout", Listener: listener}
+func FocusOut(listener func(*vecty.FocusEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "focusout", Listener: func(e *vecty.Event) {
+		listener(&vecty.FocusEvent{Event: e})
+	}}
 }
 
 // FullScreenChange is an event fired when an element was turned to fullscreen
@@ -469,8 +497,10 @@ func GamepadDisconnected(listener func(*vecty.Event)) *vecty.EventListener {
 // GotPointerCapture is an event fired when element receives pointer capture.
 //
 // https://developer.mozilla.org/docs/Web/Events/gotpointercapture
-func GotPointerCapture(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "gotpointercapture", Listener: listener}
+func GotPointerCapture(listener func(*vecty.PointerEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "gotpointercapture", Listener: func(e *vecty.Event) {
+		listener(&vecty.PointerEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
 
 // HashChange is an event fired when the fragment identifier of the URL has
@@ -485,8 +515,10 @@ func HashChange(listener func(*vecty.Event)) *vecty.EventListener {
 // of an element with the attribute contenteditable is modified.
 //
 // https://developer.mozilla.org/docs/Web/Events/input
-func Input(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "input", Listener: listener}
+func Input(listener func(*vecty.InputEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "input", Listener: func(e *vecty.Event) {
+		listener(&vecty.InputEvent{Event: e})
+	}}
 }
 
 // Invalid is an event fired when a submittable element has been checked and
@@ -500,23 +532,29 @@ func Invalid(listener func(*vecty.Event)) *vecty.EventListener {
 // KeyDown is an event fired when a key is pressed down.
 //
 // https://developer.mozilla.org/docs/Web/Events/keydown
-func KeyDown(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "keydown", Listener: listener}
+func KeyDown(listener func(*vecty.KeyboardEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "keydown", Listener: func(e *vecty.Event) {
+		listener(&vecty.KeyboardEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // KeyPress is an event fired when a key is pressed down and that key normally
 // produces a character value (use input instead).
 //
 // https://developer.mozilla.org/docs/Web/Events/keypress
-func KeyPress(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "keypress", Listener: listener}
+func KeyPress(listener func(*vecty.KeyboardEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "keypress", Listener: func(e *vecty.Event) {
+		listener(&vecty.KeyboardEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // KeyUp is an event fired when a key is released.
 //
 // https://developer.mozilla.org/docs/Web/Events/keyup
-func KeyUp(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "keyup", Listener: listener}
+func KeyUp(listener func(*vecty.KeyboardEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "keyup", Listener: func(e *vecty.Event) {
+		listener(&vecty.KeyboardEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // LanguageChange is an event fired when the user's preferred languages have
@@ -574,8 +612,10 @@ func LoadedMetadata(listener func(*vecty.Event)) *vecty.EventListener {
 // LostPointerCapture is an event fired when element lost pointer capture.
 //
 // https://developer.mozilla.org/docs/Web/Events/lostpointercapture
-func LostPointerCapture(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "lostpointercapture", Listener: listener}
+func LostPointerCapture(listener func(*vecty.PointerEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "lostpointercapture", Listener: func(e *vecty.Event) {
+		listener(&vecty.PointerEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
 
 // Mark is an event fired when the spoken utterance reaches a named SSML "mark"
@@ -598,55 +638,69 @@ func Message(listener func(*vecty.Event)) *vecty.EventListener {
 // is pressed on an element.
 //
 // https://developer.mozilla.org/docs/Web/Events/mousedown
-func MouseDown(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "mousedown", Listener: listener}
+func MouseDown(listener func(*vecty.MouseEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "mousedown", Listener: func(e *vecty.Event) {
+		listener(&vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // MouseEnter is an event fired when a pointing device is moved onto the
 // element that has the listener attached.
 //
 // https://developer.mozilla.org/docs/Web/Events/mouseenter
-func MouseEnter(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "mouseenter", Listener: listener}
+func MouseEnter(listener func(*vecty.MouseEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "mouseenter", Listener: func(e *vecty.Event) {
+		listener(&vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // MouseLeave is an event fired when a pointing device is moved off the element
 // that has the listener attached.
 //
 // https://developer.mozilla.org/docs/Web/Events/mouseleave
-func MouseLeave(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "mouseleave", Listener: listener}
+func MouseLeave(listener func(*vecty.MouseEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "mouseleave", Listener: func(e *vecty.Event) {
+		listener(&vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // MouseMove is an event fired when a pointing device is moved over an element.
 //
 // https://developer.mozilla.org/docs/Web/Events/mousemove
-func MouseMove(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "mousemove", Listener: listener}
+func MouseMove(listener func(*vecty.MouseEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "mousemove", Listener: func(e *vecty.Event) {
+		listener(&vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // MouseOut is an event fired when a pointing device is moved off the element
 // that has the listener attached or off one of its children.
 //
 // https://developer.mozilla.org/docs/Web/Events/mouseout
-func MouseOut(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "mouseout", Listener: listener}
+func MouseOut(listener func(*vecty.MouseEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "mouseout", Listener: func(e *vecty.Event) {
+		listener(&vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // MouseOver is an event fired when a pointing device is moved onto the element
 // that has the listener attached or onto one of its children.
 //
 // https://developer.mozilla.org/docs/Web/Events/mouseover
-func MouseOver(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "mouseover", Listener: listener}
+func MouseOver(listener func(*vecty.MouseEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "mouseover", Listener: func(e *vecty.Event) {
+		listener(&vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // MouseUp is an event fired when a pointing device button is released over an
 // element.
 //
 // https://developer.mozilla.org/docs/Web/Events/mouseup
-func MouseUp(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "mouseup", Listener: listener}
+func MouseUp(listener func(*vecty.MouseEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "mouseup", Listener: func(e *vecty.Event) {
+		listener(&vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // NoMatch is an event fired when the speech recognition service returns a
@@ -760,32 +814,40 @@ func Playing(listener func(*vecty.Event)) *vecty.EventListener {
 // more events.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointercancel
-func PointerCancel(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "pointercancel", Listener: listener}
+func PointerCancel(listener func(*vecty.PointerEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "pointercancel", Listener: func(e *vecty.Event) {
+		listener(&vecty.PointerEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
 
 // PointerDown is an event fired when the pointer enters the active buttons
 // state.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointerdown
-func PointerDown(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "pointerdown", Listener: listener}
+func PointerDown(listener func(*vecty.PointerEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "pointerdown", Listener: func(e *vecty.Event) {
+		listener(&vecty.PointerEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
 
 // PointerEnter is an event fired when pointing device is moved inside the
 // hit-testing boundary.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointerenter
-func PointerEnter(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "pointerenter", Listener: listener}
+func PointerEnter(listener func(*vecty.PointerEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "pointerenter", Listener: func(e *vecty.Event) {
+		listener(&vecty.PointerEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
 
 // PointerLeave is an event fired when pointing device is moved out of the
 // hit-testing boundary.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointerleave
-func PointerLeave(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "pointerleave", Listener: listener}
+func PointerLeave(listener func(*vecty.PointerEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "pointerleave", Listener: func(e *vecty.Event) {
+		listener(&vecty.PointerEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
 
 // PointerLockChange is an event fired when the pointer was locked or released.
@@ -806,32 +868,40 @@ func PointerLockError(listener func(*vecty.Event)) *vecty.EventListener {
 // PointerMove is an event fired when the pointer changed coordinates.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointermove
-func PointerMove(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "pointermove", Listener: listener}
+func PointerMove(listener func(*vecty.PointerEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "pointermove", Listener: func(e *vecty.Event) {
+		listener(&vecty.PointerEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
 
 // PointerOut is an event fired when the pointing device moved out of
 // hit-testing boundary or leaves detectable hover range.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointerout
-func PointerOut(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "pointerout", Listener: listener}
+func PointerOut(listener func(*vecty.PointerEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "pointerout", Listener: func(e *vecty.Event) {
+		listener(&vecty.PointerEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
 
 // PointerOver is an event fired when the pointing device is moved into the
 // hit-testing boundary.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointerover
-func PointerOver(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "pointerover", Listener: listener}
+func PointerOver(listener func(*vecty.PointerEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "pointerover", Listener: func(e *vecty.Event) {
+		listener(&vecty.PointerEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
 
 // PointerUp is an event fired when the pointer leaves the active buttons
 // state.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointerup
-func PointerUp(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "pointerup", Listener: listener}
+func PointerUp(listener func(*vecty.PointerEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "pointerup", Listener: func(e *vecty.Event) {
+		listener(&vecty.PointerEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
 
 // PopState is an event fired when a session history entry is being navigated
@@ -1025,8 +1095,10 @@ func SelectionChange(listener func(*vecty.Event)) *vecty.EventListener {
 // element that has a contextmenu attribute
 //
 // https://developer.mozilla.org/docs/Web/Events/show
-func Show(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "show", Listener: listener}
+func Show(listener func(*vecty.MouseEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "show", Listener: func(e *vecty.Event) {
+		listener(&vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // SoundEnd is an event fired when any sound — recognisable speech or not —
@@ -1124,32 +1196,40 @@ func Timeout(listener func(*vecty.Event)) *vecty.EventListener {
 // implementation-specific manners (too many touch points for example).
 //
 // https://developer.mozilla.org/docs/Web/Events/touchcancel
-func TouchCancel(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "touchcancel", Listener: listener}
+func TouchCancel(listener func(*vecty.TouchEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "touchcancel", Listener: func(e *vecty.Event) {
+		listener(&vecty.TouchEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // TouchEnd is an event fired when a touch point is removed from the touch
 // surface.
 //
 // https://developer.mozilla.org/docs/Web/Events/touchend
-func TouchEnd(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "touchend", Listener: listener}
+func TouchEnd(listener func(*vecty.TouchEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "touchend", Listener: func(e *vecty.Event) {
+		listener(&vecty.TouchEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // TouchMove is an event fired when a touch point is moved along the touch
 // surface.
 //
 // https://developer.mozilla.org/docs/Web/Events/touchmove
-func TouchMove(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "touchmove", Listener: listener}
+func TouchMove(listener func(*vecty.TouchEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "touchmove", Listener: func(e *vecty.Event) {
+		listener(&vecty.TouchEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // TouchStart is an event fired when a touch point is placed on the touch
 // surface.
 //
 // https://developer.mozilla.org/docs/Web/Events/touchstart
-func TouchStart(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "touchstart", Listener: listener}
+func TouchStart(listener func(*vecty.TouchEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "touchstart", Listener: func(e *vecty.Event) {
+		listener(&vecty.TouchEvent{ModifierKeys: vecty.ModifierKeys{Event: e}})
+	}}
 }
 
 // TransitionEnd is an event fired when a CSS transition has completed.
@@ -1237,6 +1317,8 @@ func Waiting(listener func(*vecty.Event)) *vecty.EventListener {
 // in any direction.
 //
 // https://developer.mozilla.org/docs/Web/Events/wheel
-func Wheel(listener func(*vecty.Event)) *vecty.EventListener {
-	return &vecty.EventListener{Name: "wheel", Listener: listener}
+func Wheel(listener func(*vecty.WheelEvent)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "wheel", Listener: func(e *vecty.Event) {
+		listener(&vecty.WheelEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}})
+	}}
 }
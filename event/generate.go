@@ -12,10 +12,25 @@ import (
 )
 
 type Event struct {
-	Name string
-	Link string
-	Desc string
-	Spec string
+	Name      string
+	Link      string
+	Desc      string
+	Spec      string
+	Interface string
+}
+
+// wrappers are the expressions wrapping the *vecty.Event e in the vecty type
+// for the event interfaces which have one. Listeners of events with other
+// interfaces get the *vecty.Event itself.
+var wrappers = map[string]string{
+	"DragEvent":     "&vecty.DragEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}}",
+	"FocusEvent":    "&vecty.FocusEvent{Event: e}",
+	"InputEvent":    "&vecty.InputEvent{Event: e}",
+	"KeyboardEvent": "&vecty.KeyboardEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}",
+	"MouseEvent":    "&vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}",
+	"PointerEvent":  "&vecty.PointerEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}}",
+	"TouchEvent":    "&vecty.TouchEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}",
+	"WheelEvent":    "&vecty.WheelEvent{MouseEvent: vecty.MouseEvent{ModifierKeys: vecty.ModifierKeys{Event: e}}}",
 }
 
 func main() {
@@ -146,6 +161,7 @@ func main() {
 		e.Link, _ = link.Attr("href")
 		e.Desc = strings.TrimSpace(cols.Eq(3).Text())
 		e.Spec = strings.TrimSpace(cols.Eq(2).Text())
+		e.Interface = strings.TrimSpace(cols.Eq(1).Text())
 
 		funName := nameMap[e.Name]
 		if funName == "" {
@@ -189,13 +205,26 @@ import "github.com/gopherjs/vecty"
 		if e.Spec == "WebVR API" {
 			continue // not stabilized
 		}
-		fmt.Fprintf(file, `%s
+		wrapper, ok := wrappers[e.Interface]
+		if !ok {
+			fmt.Fprintf(file, `%s
 //
 // https://developer.mozilla.org%s
 func %s(listener func(*vecty.Event)) *vecty.EventListener {
 	return &vecty.EventListener{Name: "%s", Listener: listener}
 }
 `, descToComments(e.Desc), e.Link[6:], name, e.Name)
+			continue
+		}
+		fmt.Fprintf(file, `%s
+//
+// https://developer.mozilla.org%s
+func %s(listener func(*vecty.%s)) *vecty.EventListener {
+	return &vecty.EventListener{Name: "%s", Listener: func(e *vecty.Event) {
+		listener(%s)
+	}}
+}
+`, descToComments(e.Desc), e.Link[6:], name, e.Interface, e.Name, wrapper)
 	}
 }
 
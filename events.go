@@ -0,0 +1,319 @@
+package vecty
+
+import "github.com/gopherjs/gopherjs/js"
+
+// Event represents a DOM event. The event constructors of the event package
+// pass the more specific event types, such as MouseEvent, to their listeners.
+type Event struct {
+	// Target is the node which the event was dispatched to.
+	Target *js.Object
+
+	// Object is the underlying JavaScript event.
+	Object *js.Object
+
+	currentTarget      *js.Object
+	propagationStopped bool
+}
+
+// Type returns the name of the event, e.g. "click".
+func (e *Event) Type() string {
+	return e.stringProperty("type")
+}
+
+// CurrentTarget returns the node of the element whose listener is handling the
+// event. Unlike the currentTarget property of the JavaScript event, it is the
+// element's node even if the event is delegated to the container.
+func (e *Event) CurrentTarget() *js.Object {
+	return e.currentTarget
+}
+
+// PreventDefault prevents the default action of the event.
+//
+// See https://developer.mozilla.org/en-US/docs/Web/API/Event/preventDefault.
+func (e *Event) PreventDefault() {
+	dom.PreventDefault(e.Object)
+}
+
+// StopPropagation prevents further propagation of the event, including the
+// emulated propagation of delegated events.
+//
+// See https://developer.mozilla.org/en-US/docs/Web/API/Event/stopPropagation.
+func (e *Event) StopPropagation() {
+	dom.StopPropagation(e.Object)
+	e.propagationStopped = true
+}
+
+// Value returns the value of the target of the event, e.g. the text of an
+// input element.
+func (e *Event) Value() string {
+	s, _ := dom.Property(e.Target, "value").(string)
+	return s
+}
+
+// Checked reports whether the target of the event, e.g. a checkbox, is
+// checked.
+func (e *Event) Checked() bool {
+	b, _ := dom.Property(e.Target, "checked").(bool)
+	return b
+}
+
+func (e *Event) stringProperty(name string) string {
+	s, _ := dom.Property(e.Object, name).(string)
+	return s
+}
+
+func (e *Event) boolProperty(name string) bool {
+	b, _ := dom.Property(e.Object, name).(bool)
+	return b
+}
+
+func (e *Event) floatProperty(name string) float64 {
+	switch v := dom.Property(e.Object, name).(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	}
+	return 0
+}
+
+func (e *Event) intProperty(name string) int {
+	return int(e.floatProperty(name))
+}
+
+func (e *Event) objectProperty(name string) *js.Object {
+	return dom.ObjectProperty(e.Object, name)
+}
+
+// ModifierKeys is embedded by the events which report the modifier keys
+// pressed when they occurred.
+type ModifierKeys struct {
+	*Event
+}
+
+// AltKey reports whether the alt key was pressed.
+func (e *ModifierKeys) AltKey() bool {
+	return e.boolProperty("altKey")
+}
+
+// CtrlKey reports whether the control key was pressed.
+func (e *ModifierKeys) CtrlKey() bool {
+	return e.boolProperty("ctrlKey")
+}
+
+// MetaKey reports whether the meta key was pressed.
+func (e *ModifierKeys) MetaKey() bool {
+	return e.boolProperty("metaKey")
+}
+
+// ShiftKey reports whether the shift key was pressed.
+func (e *ModifierKeys) ShiftKey() bool {
+	return e.boolProperty("shiftKey")
+}
+
+// MouseEvent is an event of the user interacting with a pointing device, such
+// as click and mousedown.
+type MouseEvent struct {
+	ModifierKeys
+}
+
+// ClientX returns the horizontal position of the mouse relative to the
+// viewport.
+func (e *MouseEvent) ClientX() float64 {
+	return e.floatProperty("clientX")
+}
+
+// ClientY returns the vertical position of the mouse relative to the viewport.
+func (e *MouseEvent) ClientY() float64 {
+	return e.floatProperty("clientY")
+}
+
+// PageX returns the horizontal position of the mouse relative to the document.
+func (e *MouseEvent) PageX() float64 {
+	return e.floatProperty("pageX")
+}
+
+// PageY returns the vertical position of the mouse relative to the document.
+func (e *MouseEvent) PageY() float64 {
+	return e.floatProperty("pageY")
+}
+
+// ScreenX returns the horizontal position of the mouse on the screen.
+func (e *MouseEvent) ScreenX() float64 {
+	return e.floatProperty("screenX")
+}
+
+// ScreenY returns the vertical position of the mouse on the screen.
+func (e *MouseEvent) ScreenY() float64 {
+	return e.floatProperty("screenY")
+}
+
+// OffsetX returns the horizontal position of the mouse relative to the target.
+func (e *MouseEvent) OffsetX() float64 {
+	return e.floatProperty("offsetX")
+}
+
+// OffsetY returns the vertical position of the mouse relative to the target.
+func (e *MouseEvent) OffsetY() float64 {
+	return e.floatProperty("offsetY")
+}
+
+// Button returns the button which changed state, 0 for the main button.
+func (e *MouseEvent) Button() int {
+	return e.intProperty("button")
+}
+
+// Buttons returns the buttons which are pressed, as a bit mask.
+func (e *MouseEvent) Buttons() int {
+	return e.intProperty("buttons")
+}
+
+// KeyboardEvent is an event of the user interacting with the keyboard, such as
+// keydown.
+type KeyboardEvent struct {
+	ModifierKeys
+}
+
+// Key returns the value of the key, e.g. "a" or "Enter".
+func (e *KeyboardEvent) Key() string {
+	return e.stringProperty("key")
+}
+
+// Code returns the physical key, e.g. "KeyA" or "Enter".
+func (e *KeyboardEvent) Code() string {
+	return e.stringProperty("code")
+}
+
+// Location returns the location of the key on the keyboard.
+func (e *KeyboardEvent) Location() int {
+	return e.intProperty("location")
+}
+
+// Repeat reports whether the key is held down and repeating.
+func (e *KeyboardEvent) Repeat() bool {
+	return e.boolProperty("repeat")
+}
+
+// IsComposing reports whether the event is part of a composition session.
+func (e *KeyboardEvent) IsComposing() bool {
+	return e.boolProperty("isComposing")
+}
+
+// InputEvent is an event of the user changing editable content, such as
+// input.
+type InputEvent struct {
+	*Event
+}
+
+// Data returns the inserted text, if any.
+func (e *InputEvent) Data() string {
+	return e.stringProperty("data")
+}
+
+// InputType returns the kind of change, e.g. "insertText".
+func (e *InputEvent) InputType() string {
+	return e.stringProperty("inputType")
+}
+
+// IsComposing reports whether the event is part of a composition session.
+func (e *InputEvent) IsComposing() bool {
+	return e.boolProperty("isComposing")
+}
+
+// FocusEvent is an event of an element receiving or losing focus, such as
+// focus and blur.
+type FocusEvent struct {
+	*Event
+}
+
+// RelatedTarget returns the node losing focus for focus events, or receiving
+// it for blur events, if any.
+func (e *FocusEvent) RelatedTarget() *js.Object {
+	return e.objectProperty("relatedTarget")
+}
+
+// WheelEvent is an event of the user rotating a wheel of a pointing device.
+type WheelEvent struct {
+	MouseEvent
+}
+
+// DeltaX returns the horizontal scroll amount.
+func (e *WheelEvent) DeltaX() float64 {
+	return e.floatProperty("deltaX")
+}
+
+// DeltaY returns the vertical scroll amount.
+func (e *WheelEvent) DeltaY() float64 {
+	return e.floatProperty("deltaY")
+}
+
+// DeltaZ returns the scroll amount along the z-axis.
+func (e *WheelEvent) DeltaZ() float64 {
+	return e.floatProperty("deltaZ")
+}
+
+// DeltaMode returns the unit of the scroll amounts: 0 for pixels, 1 for lines
+// and 2 for pages.
+func (e *WheelEvent) DeltaMode() int {
+	return e.intProperty("deltaMode")
+}
+
+// PointerEvent is an event of a pointer such as a mouse, pen or finger.
+type PointerEvent struct {
+	MouseEvent
+}
+
+// PointerID returns the unique identifier of the pointer.
+func (e *PointerEvent) PointerID() int {
+	return e.intProperty("pointerId")
+}
+
+// PointerType returns the kind of pointer: "mouse", "pen" or "touch".
+func (e *PointerEvent) PointerType() string {
+	return e.stringProperty("pointerType")
+}
+
+// IsPrimary reports whether the pointer is the primary one of its kind.
+func (e *PointerEvent) IsPrimary() bool {
+	return e.boolProperty("isPrimary")
+}
+
+// Width returns the width of the contact geometry of the pointer.
+func (e *PointerEvent) Width() float64 {
+	return e.floatProperty("width")
+}
+
+// Height returns the height of the contact geometry of the pointer.
+func (e *PointerEvent) Height() float64 {
+	return e.floatProperty("height")
+}
+
+// Pressure returns the pressure of the pointer between 0 and 1.
+func (e *PointerEvent) Pressure() float64 {
+	return e.floatProperty("pressure")
+}
+
+// DragEvent is an event of a drag and drop interaction.
+type DragEvent struct {
+	MouseEvent
+}
+
+// DataTransfer returns the data being dragged.
+func (e *DragEvent) DataTransfer() *js.Object {
+	return e.objectProperty("dataTransfer")
+}
+
+// TouchEvent is an event of touch points on a touch-sensitive surface.
+type TouchEvent struct {
+	ModifierKeys
+}
+
+// Touches returns the list of all current touch points.
+func (e *TouchEvent) Touches() *js.Object {
+	return e.objectProperty("touches")
+}
+
+// ChangedTouches returns the list of the touch points which changed.
+func (e *TouchEvent) ChangedTouches() *js.Object {
+	return e.objectProperty("changedTouches")
+}
@@ -0,0 +1,76 @@
+// +build !js
+
+package vecty_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+	"github.com/gopherjs/vecty/memdom"
+	"github.com/gopherjs/vecty/vectytest"
+)
+
+func TestEventProperties(t *testing.T) {
+	var (
+		got           []interface{}
+		relatedTarget *js.Object
+	)
+	doc := vectytest.Mount(elem.Div(
+		elem.Button(
+			event.MouseDown(func(e *vecty.MouseEvent) {
+				got = append(got, e.Type(), e.ClientX(), e.Button(), e.ShiftKey(), e.AltKey())
+			}),
+			event.Wheel(func(e *vecty.WheelEvent) {
+				got = append(got, e.Type(), e.DeltaY(), e.AltKey())
+			}),
+			event.KeyDown(func(e *vecty.KeyboardEvent) {
+				got = append(got, e.Type(), e.Key(), e.CtrlKey())
+			}),
+			event.Drop(func(e *vecty.DragEvent) {
+				got = append(got, e.Type(), e.DataTransfer() == nil)
+			}),
+			event.TouchStart(func(e *vecty.TouchEvent) {
+				got = append(got, e.Type(), e.Touches() == nil, e.MetaKey())
+			}),
+		),
+		elem.Input(
+			event.Focus(func(e *vecty.FocusEvent) {
+				relatedTarget = e.RelatedTarget()
+			}),
+		),
+	))
+	defer doc.Close()
+
+	button, input := doc.ByTag("button")[0], doc.ByTag("input")[0]
+	dispatch := func(target *memdom.Node, name string, properties map[string]interface{}) {
+		doc.DispatchEvent(target, &memdom.Event{Name: name, Properties: properties})
+	}
+	dispatch(button, "mousedown", map[string]interface{}{"clientX": 3.0, "button": 2.0, "shiftKey": true})
+	dispatch(button, "wheel", map[string]interface{}{"deltaY": -1.5, "altKey": true})
+	dispatch(button, "keydown", map[string]interface{}{"key": "a", "ctrlKey": true})
+	dispatch(button, "drop", nil)
+	dispatch(button, "touchstart", map[string]interface{}{"metaKey": true})
+	dispatch(input, "focus", map[string]interface{}{"relatedTarget": button})
+
+	want := []interface{}{
+		"mousedown", 3.0, 2, true, false,
+		"wheel", -1.5, true,
+		"keydown", "a", true,
+		"drop", true,
+		"touchstart", true, true,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+	if relatedTarget != button.Handle() {
+		t.Errorf("got related target %v, want the button", doc.Node(relatedTarget))
+	}
+}
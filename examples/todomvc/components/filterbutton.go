@@ -6,11 +6,13 @@ import (
 	"github.com/gopherjs/vecty/event"
 	"github.com/gopherjs/vecty/examples/todomvc/actions"
 	"github.com/gopherjs/vecty/examples/todomvc/dispatcher"
-	"github.com/gopherjs/vecty/examples/todomvc/store"
 	"github.com/gopherjs/vecty/examples/todomvc/store/model"
 	"github.com/gopherjs/vecty/prop"
 )
 
+// filterContext supplies the current filter to the filter buttons.
+var filterContext = vecty.NewContext(model.All)
+
 type FilterButton struct {
 	vecty.Composite
 
@@ -31,7 +33,7 @@ func (b *FilterButton) Reconcile(oldComp vecty.Component) {
 	b.ReconcileBody()
 }
 
-func (b *FilterButton) onClick(event *vecty.Event) {
+func (b *FilterButton) onClick(event *vecty.MouseEvent) {
 	dispatcher.Dispatch(&actions.SetFilter{
 		Filter: b.Filter,
 	})
@@ -40,7 +42,7 @@ func (b *FilterButton) onClick(event *vecty.Event) {
 func (b *FilterButton) render() vecty.Component {
 	return elem.ListItem(
 		elem.Anchor(
-			vecty.If(store.Filter == b.Filter, prop.Class("selected")),
+			vecty.If(filterContext.Value(b).(model.FilterState) == b.Filter, prop.Class("selected")),
 			prop.Href("#"),
 			event.Click(b.onClick).PreventDefault(),
 
@@ -7,6 +7,7 @@ import (
 	"github.com/gopherjs/vecty/examples/todomvc/actions"
 	"github.com/gopherjs/vecty/examples/todomvc/dispatcher"
 	"github.com/gopherjs/vecty/examples/todomvc/store/model"
+	"github.com/gopherjs/vecty/form"
 	"github.com/gopherjs/vecty/prop"
 	"github.com/gopherjs/vecty/style"
 )
@@ -18,7 +19,7 @@ type ItemView struct {
 	Item      *model.Item
 	editing   bool
 	editTitle string
-	input     *vecty.Element
+	input     vecty.Ref
 }
 
 // Apply implements the vecty.Markup interface.
@@ -26,6 +27,11 @@ func (p *ItemView) Apply(element *vecty.Element) {
 	element.AddChild(p)
 }
 
+// Key implements the vecty.Keyer interface.
+func (p *ItemView) Key() interface{} {
+	return p.Item
+}
+
 func (p *ItemView) Reconcile(oldComp vecty.Component) {
 	if oldComp, ok := oldComp.(*ItemView); ok {
 		p.Body = oldComp.Body
@@ -36,7 +42,7 @@ func (p *ItemView) Reconcile(oldComp vecty.Component) {
 	p.ReconcileBody()
 }
 
-func (p *ItemView) onDestroy(event *vecty.Event) {
+func (p *ItemView) onDestroy(event *vecty.MouseEvent) {
 	dispatcher.Dispatch(&actions.DestroyItem{
 		Index: p.Index,
 	})
@@ -45,25 +51,21 @@ func (p *ItemView) onDestroy(event *vecty.Event) {
 func (p *ItemView) onToggleCompleted(event *vecty.Event) {
 	dispatcher.Dispatch(&actions.SetCompleted{
 		Index:     p.Index,
-		Completed: event.Target.Get("checked").Bool(),
+		Completed: event.Checked(),
 	})
 }
 
-func (p *ItemView) onStartEdit(event *vecty.Event) {
+func (p *ItemView) onStartEdit(event *vecty.MouseEvent) {
 	p.editing = true
 	p.editTitle = p.Item.Title
-	p.ReconcileBody()
+	vecty.Rerender(p)
+	vecty.Flush() // the input must be rendered before it can be focused
 	p.input.Node().Call("focus")
 }
 
-func (p *ItemView) onEditInput(event *vecty.Event) {
-	p.editTitle = event.Target.Get("value").String()
-	p.ReconcileBody()
-}
-
 func (p *ItemView) onStopEdit(event *vecty.Event) {
 	p.editing = false
-	p.ReconcileBody()
+	vecty.Rerender(p)
 	dispatcher.Dispatch(&actions.SetTitle{
 		Index: p.Index,
 		Title: p.editTitle,
@@ -71,12 +73,6 @@ func (p *ItemView) onStopEdit(event *vecty.Event) {
 }
 
 func (p *ItemView) render() vecty.Component {
-	p.input = elem.Input(
-		prop.Class("edit"),
-		prop.Value(p.editTitle),
-		event.Input(p.onEditInput),
-	)
-
 	return elem.ListItem(
 		vecty.ClassMap{
 			"completed": p.Item.Completed,
@@ -104,7 +100,11 @@ func (p *ItemView) render() vecty.Component {
 		elem.Form(
 			style.Margin(style.Px(0)),
 			event.Submit(p.onStopEdit).PreventDefault(),
-			p.input,
+			elem.Input(
+				&p.input,
+				prop.Class("edit"),
+				form.BindString(p, &p.editTitle),
+			),
 		),
 	)
 }
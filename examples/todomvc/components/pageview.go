@@ -10,6 +10,7 @@ import (
 	"github.com/gopherjs/vecty/examples/todomvc/dispatcher"
 	"github.com/gopherjs/vecty/examples/todomvc/store"
 	"github.com/gopherjs/vecty/examples/todomvc/store/model"
+	"github.com/gopherjs/vecty/form"
 	"github.com/gopherjs/vecty/prop"
 	"github.com/gopherjs/vecty/style"
 )
@@ -35,9 +36,24 @@ func (p *PageView) Reconcile(oldComp vecty.Component) {
 	p.ReconcileBody()
 }
 
-func (p *PageView) onNewItemTitleInput(event *vecty.Event) {
-	p.newItemTitle = event.Target.Get("value").String()
-	p.ReconcileBody()
+// Mount implements the vecty.Mounter interface.
+func (p *PageView) Mount() {
+	store.Listeners.Add(p, func() {
+		p.Items = store.Items
+		vecty.Rerender(p)
+	})
+}
+
+// Updated implements the vecty.Updater interface. It takes over the store
+// listener of the PageView which p replaces.
+func (p *PageView) Updated(oldComp vecty.Component) {
+	store.Listeners.Remove(oldComp)
+	p.Mount()
+}
+
+// Unmount implements the vecty.Unmounter interface.
+func (p *PageView) Unmount() {
+	store.Listeners.Remove(p)
 }
 
 func (p *PageView) onAdd(event *vecty.Event) {
@@ -45,16 +61,16 @@ func (p *PageView) onAdd(event *vecty.Event) {
 		Title: p.newItemTitle,
 	})
 	p.newItemTitle = ""
-	p.ReconcileBody()
+	vecty.Rerender(p)
 }
 
-func (p *PageView) onClearCompleted(event *vecty.Event) {
+func (p *PageView) onClearCompleted(event *vecty.MouseEvent) {
 	dispatcher.Dispatch(&actions.ClearCompleted{})
 }
 
 func (p *PageView) onToggleAllCompleted(event *vecty.Event) {
 	dispatcher.Dispatch(&actions.SetAllCompleted{
-		Completed: event.Target.Get("checked").Bool(),
+		Completed: event.Checked(),
 	})
 }
 
@@ -89,8 +105,7 @@ func (p *PageView) renderHeader() vecty.Markup {
 				prop.Class("new-todo"),
 				prop.Placeholder("What needs to be done?"),
 				prop.Autofocus(true),
-				prop.Value(p.newItemTitle),
-				event.Input(p.onNewItemTitleInput),
+				form.BindString(p, &p.newItemTitle),
 			),
 		),
 	)
@@ -115,13 +130,15 @@ func (p *PageView) renderFooter() vecty.Component {
 			vecty.Text(itemsLeftText),
 		),
 
-		elem.UnorderedList(
-			prop.Class("filters"),
-			&FilterButton{Label: "All", Filter: model.All},
-			vecty.Text(" "),
-			&FilterButton{Label: "Active", Filter: model.Active},
-			vecty.Text(" "),
-			&FilterButton{Label: "Completed", Filter: model.Completed},
+		filterContext.Provide(store.Filter,
+			elem.UnorderedList(
+				prop.Class("filters"),
+				&FilterButton{Label: "All", Filter: model.All},
+				vecty.Text(" "),
+				&FilterButton{Label: "Active", Filter: model.Active},
+				vecty.Text(" "),
+				&FilterButton{Label: "Completed", Filter: model.Completed},
+			),
 		),
 
 		vecty.If(store.CompletedItemCount() > 0,
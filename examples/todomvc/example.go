@@ -19,12 +19,7 @@ func main() {
 	vecty.SetTitle("GopherJS • TodoMVC")
 	vecty.AddStylesheet("node_modules/todomvc-common/base.css")
 	vecty.AddStylesheet("node_modules/todomvc-app-css/index.css")
-	p := &components.PageView{}
-	store.Listeners.Add(p, func() {
-		p.Items = store.Items
-		p.ReconcileBody()
-	})
-	vecty.RenderAsBody(p)
+	vecty.RenderAsBody(&components.PageView{})
 }
 
 func attachLocalStorage() {
@@ -0,0 +1,74 @@
+// Package form defines markup which binds form elements to fields of
+// components in both directions: the element shows the value of the field, and
+// user input is stored in the field and renders the component again.
+//
+//  func (p *Editor) render() vecty.Component {
+//  	return elem.Form(
+//  		elem.Input(form.BindString(p, &p.title)),
+//  		elem.Input(prop.Type(prop.TypeCheckbox), form.BindBool(p, &p.done)),
+//  	)
+//  }
+//
+// The owner passed to the binders is the component to render again, which
+// must embed vecty.Composite, as with vecty.Rerender.
+package form
+
+import (
+	"strconv"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/event"
+	"github.com/gopherjs/vecty/prop"
+)
+
+// BindString binds the value of a text input or textarea to s.
+func BindString(owner vecty.Component, s *string) vecty.Markup {
+	return vecty.List{
+		prop.Value(*s),
+		event.Input(func(e *vecty.InputEvent) {
+			*s = e.Value()
+			vecty.Rerender(owner)
+		}),
+	}
+}
+
+// BindBool binds the checked state of a checkbox to b.
+func BindBool(owner vecty.Component, b *bool) vecty.Markup {
+	return vecty.List{
+		prop.Checked(*b),
+		event.Change(func(e *vecty.Event) {
+			*b = e.Checked()
+			vecty.Rerender(owner)
+		}),
+	}
+}
+
+// BindInt binds the value of an input, e.g. of type number, to n. Input which
+// is not a valid integer, such as a lone minus sign while typing, leaves n
+// unchanged. The input keeps the text as typed only until the owner is
+// rendered again, which resets it to n.
+func BindInt(owner vecty.Component, n *int) vecty.Markup {
+	return vecty.List{
+		prop.Value(strconv.Itoa(*n)),
+		event.Input(func(e *vecty.InputEvent) {
+			v, err := strconv.Atoi(e.Value())
+			if err != nil {
+				return
+			}
+			*n = v
+			vecty.Rerender(owner)
+		}),
+	}
+}
+
+// BindSelect binds the value of a select element, i.e. the value of its
+// selected option, to s.
+func BindSelect(owner vecty.Component, s *string) vecty.Markup {
+	return vecty.List{
+		prop.Value(*s),
+		event.Change(func(e *vecty.Event) {
+			*s = e.Value()
+			vecty.Rerender(owner)
+		}),
+	}
+}
@@ -0,0 +1,93 @@
+// +build !js
+
+package form_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/form"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/gopherjs/vecty/vectytest"
+)
+
+type editor struct {
+	vecty.Composite
+
+	title   string
+	done    bool
+	count   int
+	color   string
+	renders int
+}
+
+func (e *editor) Apply(element *vecty.Element) {
+	element.AddChild(e)
+}
+
+func (e *editor) Reconcile(oldComp vecty.Component) {
+	if oldComp, ok := oldComp.(*editor); ok {
+		e.Body = oldComp.Body
+	}
+	e.RenderFunc = e.render
+	e.ReconcileBody()
+}
+
+func (e *editor) render() vecty.Component {
+	e.renders++
+	return elem.Form(
+		elem.Input(form.BindString(e, &e.title)),
+		elem.Input(prop.Type(prop.TypeCheckbox), form.BindBool(e, &e.done)),
+		elem.Input(prop.Type(prop.TypeNumber), form.BindInt(e, &e.count)),
+		elem.Select(
+			form.BindSelect(e, &e.color),
+			elem.Option(prop.Value("red")),
+			elem.Option(prop.Value("blue")),
+		),
+	)
+}
+
+func TestBind(t *testing.T) {
+	e := &editor{title: "a", count: 1, color: "red"}
+	doc := vectytest.Mount(e)
+	defer doc.Close()
+	inputs := doc.ByTag("input")
+	sel := doc.ByTag("select")[0]
+	if inputs[0].Properties["value"] != "a" || inputs[2].Properties["value"] != "1" || sel.Properties["value"] != "red" {
+		t.Fatalf("got values %v, %v and %v, want the fields", inputs[0].Properties["value"], inputs[2].Properties["value"], sel.Properties["value"])
+	}
+
+	doc.Input(inputs[0], "ab")
+	doc.Check(inputs[1], true)
+	doc.Input(inputs[2], "-2")
+	sel.Properties["value"] = "blue"
+	doc.Fire(sel, "change")
+	if e.title != "ab" || !e.done || e.count != -2 || e.color != "blue" {
+		t.Errorf("got title %q, done %v, count %d and color %q", e.title, e.done, e.count, e.color)
+	}
+	vecty.Flush()
+	if e.renders != 2 {
+		t.Errorf("got %d renders, want 2", e.renders)
+	}
+}
+
+func TestBindIntInvalid(t *testing.T) {
+	e := &editor{count: 1}
+	doc := vectytest.Mount(e)
+	defer doc.Close()
+	input := doc.ByTag("input")[2]
+
+	doc.Input(input, "-")
+	vecty.Flush()
+	if e.count != 1 || e.renders != 1 || input.Properties["value"] != "-" {
+		t.Errorf("got count %d, %d renders and value %v, want the input left as typed", e.count, e.renders, input.Properties["value"])
+	}
+
+	// Rendering the owner again resets the input.
+	vecty.Rerender(e)
+	vecty.Flush()
+	if got := input.Properties["value"]; got != "1" {
+		t.Errorf("got value %v after rendering again, want 1", got)
+	}
+}
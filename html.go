@@ -0,0 +1,414 @@
+package vecty
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"sort"
+	"strings"
+)
+
+// RenderToString renders the given component to a string of HTML. See
+// RenderHTML.
+func RenderToString(comp Component) (string, error) {
+	var buf bytes.Buffer
+	if err := RenderHTML(&buf, comp); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// RenderHTML renders the given component as HTML to w. Composites which have
+// not been rendered yet are rendered through their Reconcile method, but no DOM
+// nodes are created, so RenderHTML does not depend on GopherJS and can be used
+// by a Go HTTP server or in plain tests. Such composites are left unrendered,
+// so that they can still be passed to Render or Hydrate afterwards.
+//
+// Properties are written as the attributes reflecting them, e.g. "className"
+// as "class". Boolean properties are written as boolean attributes.
+// Attributes are written with their value converted to a string, like
+// setAttribute does.
+//
+// RenderHTML does not use any state shared with other renders, so it may be
+// called concurrently, e.g. by the handlers of an HTTP server, as long as each
+// call renders components of its own.
+func RenderHTML(w io.Writer, comp Component) error {
+	hw := &htmlWriter{w: w}
+	hw.component(comp)
+	return hw.err
+}
+
+// RenderHTMLIndent is like RenderHTML, but puts every element and text on a
+// line of its own, indented by its depth in the tree. The result is meant for
+// humans, e.g. in snapshot tests, as the indentation adds whitespace to the
+// document.
+func RenderHTMLIndent(w io.Writer, comp Component, indent string) error {
+	hw := &htmlWriter{w: w, indent: indent}
+	hw.component(comp)
+	hw.write("\n")
+	return hw.err
+}
+
+// voidElements are the HTML elements which have no end tag and may not have
+// children.
+var voidElements = map[string]bool{
+	"area":   true,
+	"base":   true,
+	"br":     true,
+	"col":    true,
+	"embed":  true,
+	"hr":     true,
+	"img":    true,
+	"input":  true,
+	"link":   true,
+	"meta":   true,
+	"param":  true,
+	"source": true,
+	"track":  true,
+	"wbr":    true,
+}
+
+// attributeNames translates the names of properties whose reflecting HTML
+// attribute is not simply the lowercase property name.
+var attributeNames = map[string]string{
+	"acceptCharset": "accept-charset",
+	"className":     "class",
+	"htmlFor":       "for",
+	"httpEquiv":     "http-equiv",
+}
+
+type htmlWriter struct {
+	w      io.Writer
+	indent string
+	depth  int
+	err    error
+
+	// prevText is set if the last node written was text.
+	prevText bool
+
+	// parent is the composite whose body is being written, and path are the
+	// components being written, from the outermost to the innermost one.
+	// Like rendering and reconciling, but for this render only.
+	parent *Composite
+	path   []Component
+
+	// selection is the selection of the <select> element being written, and
+	// namespace the namespace inherited by the elements being written.
+	selection *htmlSelection
+	namespace string
+}
+
+// htmlSelection is the value or selected index of a <select> element, which
+// HTML has no attribute for. It is written as the selected attribute of the
+// matching <option> instead.
+type htmlSelection struct {
+	value    interface{} // nil if the value is not set
+	index    int         // -1 if the selected index is not set
+	options  int         // the number of options written so far
+	selected bool        // whether an option has been selected already
+}
+
+// newHTMLSelection returns the selection of the <select> element e, or nil if
+// it has neither a value nor a selected index.
+func newHTMLSelection(e *Element) *htmlSelection {
+	s := &htmlSelection{value: e.Properties["value"], index: -1}
+	if i, ok := e.Properties["selectedIndex"].(int); ok {
+		s.index = i
+	}
+	if s.value == nil && s.index == -1 {
+		return nil
+	}
+	return s
+}
+
+// selects reports whether the given <option>, which is the next one of the
+// <select> element, is selected. The value takes precedence over the selected
+// index if both are set.
+func (s *htmlSelection) selects(option *Element) bool {
+	i := s.options
+	s.options++
+	if s.selected {
+		return false
+	}
+	if s.value != nil {
+		s.selected = optionValue(option) == fmt.Sprint(s.value)
+	} else {
+		s.selected = i == s.index
+	}
+	return s.selected
+}
+
+// optionValue returns the value of an <option>, which defaults to its text.
+func optionValue(option *Element) string {
+	if value, ok := option.Properties["value"]; ok {
+		return fmt.Sprint(value)
+	}
+	var text string
+	for _, c := range option.Children {
+		if t, ok := c.(*textComponent); ok {
+			text += t.text
+		}
+	}
+	return strings.TrimSpace(text)
+}
+
+func (hw *htmlWriter) write(s string) {
+	if hw.err != nil {
+		return
+	}
+	_, hw.err = io.WriteString(hw.w, s)
+}
+
+// newline starts a new line at the current depth, unless indentation is
+// disabled.
+func (hw *htmlWriter) newline() {
+	if hw.indent == "" {
+		return
+	}
+	hw.write("\n" + strings.Repeat(hw.indent, hw.depth))
+}
+
+func (hw *htmlWriter) component(comp Component) {
+	if hw.err != nil {
+		return
+	}
+	// Like reconcileComponent, comp stays on the path if it panics.
+	hw.path = append(hw.path, comp)
+	hw.writeComponent(comp)
+	hw.path = hw.path[:len(hw.path)-1]
+}
+
+// writeComponent writes comp, which is on the path.
+func (hw *htmlWriter) writeComponent(comp Component) {
+	switch c := comp.(type) {
+	case *textComponent:
+		if hw.prevText && hw.indent == "" {
+			// Separate adjacent text, which would otherwise be parsed as a
+			// single text node and break hydration.
+			hw.write("<!---->")
+		}
+		hw.write(html.EscapeString(c.text))
+		hw.prevText = true
+	case *portal:
+		// The children of a portal are not part of the markup.
+	case *fragment:
+		for i, child := range c.children {
+			if i != 0 {
+				hw.newline()
+			}
+			hw.component(child)
+		}
+	case *Element:
+		hw.element(c)
+	case compositeComponent:
+		hw.composite(comp, c.composite())
+	default:
+		hw.err = fmt.Errorf("vecty: cannot render component of type %T as HTML", comp)
+	}
+}
+
+// composite writes the body of comp, whose composite is cc. If comp has not
+// been rendered yet, its body is only rendered for the HTML, so that comp can
+// still be rendered into the DOM afterwards.
+func (hw *htmlWriter) composite(comp Component, cc *Composite) {
+	if cc.Body == nil {
+		defer func() {
+			cc.static = false
+			cc.Body = nil
+		}()
+		cc.parent = hw.parent
+		cc.static = true
+		comp.Reconcile(nil)
+	}
+	prevParent := hw.parent
+	hw.parent = cc
+	defer func() {
+		hw.parent = prevParent
+	}()
+	if b := cc.boundary; b != nil && !b.failed {
+		hw.boundaryChild(b)
+		return
+	}
+	hw.component(cc.Body)
+}
+
+// boundaryChild writes the child of the error boundary b, or its fallback if
+// rendering the child panics.
+func (hw *htmlWriter) boundaryChild(b *ErrorBoundary) {
+	// The child is written to a buffer first, so that nothing of it is
+	// written if it panics halfway.
+	var buf bytes.Buffer
+	child := *hw
+	child.w = &buf
+	if child.catch(b, func() { child.component(b.Body) }) {
+		if child.err != nil {
+			hw.err = child.err
+			return
+		}
+		hw.write(buf.String())
+		hw.prevText = child.prevText
+		return
+	}
+	b.Body = b.render()
+	hw.component(b.Body)
+}
+
+// catch calls f like b.catch does, but with the path of the components being
+// written by hw instead of the ones being reconciled.
+func (hw *htmlWriter) catch(b *ErrorBoundary, f func()) (ok bool) {
+	pathLen := len(hw.path)
+	defer func() {
+		if ok {
+			return
+		}
+		recovered := recover()
+		path := append([]Component(nil), hw.path[pathLen:]...)
+		hw.path = hw.path[:pathLen]
+		b.fail(recovered, path)
+	}()
+	f()
+	return true
+}
+
+func (hw *htmlWriter) element(e *Element) {
+	e.inheritNamespace(hw.namespace)
+	e.propertiesToAttributes()
+	if e.UnsafeHTML != "" && len(e.Children) != 0 && hw.err == nil {
+		hw.err = fmt.Errorf("vecty: element <%s> has both unsafe HTML and children", e.TagName)
+	}
+	hw.write("<" + e.TagName)
+
+	var textContent *string
+	for _, name := range sortedKeys(e.Properties) {
+		value := e.Properties[name]
+		if name == "value" && e.TagName == "textarea" {
+			s := fmt.Sprint(value)
+			textContent = &s
+			continue
+		}
+		if e.setAfterChildren(name) {
+			continue // written as the selection of the options
+		}
+		hw.attribute(propertyAttribute(name), value)
+	}
+	if e.TagName == "option" && hw.selection != nil && hw.selection.selects(e) {
+		if _, ok := e.Properties["selected"]; !ok {
+			hw.write(" selected")
+		}
+	}
+	for _, name := range sortedKeys(e.Attributes) {
+		value := e.Attributes[name]
+		if b, ok := value.(bool); ok {
+			value = fmt.Sprint(b)
+		}
+		hw.attribute(name, value)
+	}
+	if len(e.Style) != 0 {
+		var decls []string
+		for _, name := range sortedKeys(e.Style) {
+			decls = append(decls, fmt.Sprintf("%s: %v", name, e.Style[name]))
+		}
+		hw.attribute("style", strings.Join(decls, "; "))
+	}
+	var dataNames []string
+	for name := range e.Dataset {
+		dataNames = append(dataNames, name)
+	}
+	sort.Strings(dataNames)
+	for _, name := range dataNames {
+		hw.attribute(datasetAttribute(name), e.Dataset[name])
+	}
+	hw.write(">")
+	hw.prevText = false
+	defer func() {
+		hw.prevText = false
+	}()
+
+	if e.Namespace == "" && voidElements[e.TagName] {
+		if (len(e.Children) != 0 || e.UnsafeHTML != "") && hw.err == nil {
+			hw.err = fmt.Errorf("vecty: void element <%s> cannot have children", e.TagName)
+		}
+		return
+	}
+	if e.TagName == "select" {
+		prevSelection := hw.selection
+		hw.selection = newHTMLSelection(e)
+		defer func() {
+			hw.selection = prevSelection
+		}()
+	}
+	prevNamespace := hw.namespace
+	hw.namespace = e.childNamespace()
+	defer func() {
+		hw.namespace = prevNamespace
+	}()
+	hw.depth++
+	if textContent != nil {
+		hw.newline()
+		hw.write(html.EscapeString(*textContent))
+	}
+	if e.UnsafeHTML != "" {
+		hw.newline()
+		hw.write(e.UnsafeHTML)
+	}
+	for _, c := range e.Children {
+		hw.newline()
+		hw.component(c)
+	}
+	hw.depth--
+	if textContent != nil || e.UnsafeHTML != "" || len(e.Children) != 0 {
+		hw.newline()
+	}
+	hw.write("</" + e.TagName + ">")
+}
+
+func (hw *htmlWriter) attribute(name string, value interface{}) {
+	switch v := value.(type) {
+	case nil:
+	case bool:
+		if v {
+			hw.write(" " + name)
+		}
+	case string:
+		hw.write(fmt.Sprintf(` %s="%s"`, name, html.EscapeString(v)))
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, fmt.Stringer:
+		hw.write(fmt.Sprintf(` %s="%s"`, name, html.EscapeString(fmt.Sprint(v))))
+	default:
+		if hw.err == nil {
+			hw.err = fmt.Errorf("vecty: cannot render attribute %q of type %T as HTML", name, value)
+		}
+	}
+}
+
+// propertyAttribute returns the name of the HTML attribute reflecting the
+// named DOM property.
+func propertyAttribute(name string) string {
+	if attr, ok := attributeNames[name]; ok {
+		return attr
+	}
+	return strings.ToLower(name)
+}
+
+// datasetAttribute returns the name of the data attribute for the given
+// dataset key, e.g. "data-foo-bar" for "fooBar".
+func datasetAttribute(name string) string {
+	attr := "data-"
+	for _, r := range name {
+		if 'A' <= r && r <= 'Z' {
+			attr += "-" + string(r-'A'+'a')
+			continue
+		}
+		attr += string(r)
+	}
+	return attr
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,125 @@
+// +build !js
+
+package vecty_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/gopherjs/vecty/svg"
+	"github.com/gopherjs/vecty/vectytest"
+)
+
+// greeting is a composite which renders a fixed greeting.
+type greeting struct {
+	vecty.Composite
+}
+
+func (g *greeting) Apply(element *vecty.Element) {
+	element.AddChild(g)
+}
+
+func (g *greeting) Reconcile(oldComp vecty.Component) {
+	g.RenderFunc = func() vecty.Component {
+		return elem.Paragraph(vecty.Text("hello"))
+	}
+	g.ReconcileBody()
+}
+
+func TestRenderToStringThenRender(t *testing.T) {
+	g := &greeting{}
+	html, err := vecty.RenderToString(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "<p>hello</p>"; html != want {
+		t.Errorf("got %q, want %q", html, want)
+	}
+
+	doc := vectytest.Mount(g)
+	defer doc.Close()
+	if got := doc.Container().Text(); got != "hello" {
+		t.Errorf("got text %q after rendering into the DOM, want %q", got, "hello")
+	}
+}
+
+func TestRenderHTMLConcurrent(t *testing.T) {
+	page := func() vecty.Component {
+		return elem.Div(
+			&greeting{},
+			labelContext.Provide("provided", &label{}),
+			&vecty.ErrorBoundary{
+				Child: &panicky{fail: true},
+				Fallback: func(recovered interface{}) vecty.Component {
+					return vecty.Text(fmt.Sprint(recovered))
+				},
+			},
+		)
+	}
+	want := "<div><p>hello</p><span>provided</span>render failed</div>"
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 50)
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := vecty.RenderToString(page())
+			if err != nil || got != want {
+				errs <- fmt.Sprintf("got %q, %v", got, err)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("%s, want %q", err, want)
+	}
+}
+
+func TestRenderHTMLSelect(t *testing.T) {
+	tests := []struct {
+		selection vecty.Markup
+		want      string
+	}{
+		{prop.Value("b"), `<option value="a">A</option><optgroup><option value="b" selected>B</option></optgroup><option>c</option>`},
+		{prop.Value("c"), `<option value="a">A</option><optgroup><option value="b">B</option></optgroup><option selected>c</option>`},
+		{vecty.Property("selectedIndex", 1), `<option value="a">A</option><optgroup><option value="b" selected>B</option></optgroup><option>c</option>`},
+		{nil, `<option value="a">A</option><optgroup><option value="b">B</option></optgroup><option>c</option>`},
+	}
+	for _, tt := range tests {
+		got, err := vecty.RenderToString(elem.Select(
+			tt.selection,
+			elem.Option(prop.Value("a"), vecty.Text("A")),
+			elem.OptionsGroup(elem.Option(prop.Value("b"), vecty.Text("B"))),
+			elem.Option(vecty.Text("c")),
+		))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "<select>" + tt.want + "</select>"; got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	}
+}
+
+func TestRenderHTMLNamespaces(t *testing.T) {
+	html, err := vecty.RenderToString(svg.SVG(
+		vecty.Fragment(&vecty.Element{TagName: "rect", Properties: map[string]interface{}{"className": "a"}}),
+		&rendered{render: func() vecty.Component {
+			return &vecty.Element{TagName: "br"}
+		}},
+		svg.ForeignObject(elem.Break()),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Only the HTML <br> is a void element.
+	if want := `<svg><rect class="a"></rect><br></br><foreignObject><br></foreignObject></svg>`; html != want {
+		t.Errorf("got %q, want %q", html, want)
+	}
+}
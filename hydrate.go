@@ -0,0 +1,244 @@
+package vecty
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// DevelopmentMode enables checks which are helpful during development but too
+// costly or noisy for production, such as reporting mismatches between
+// server-rendered markup and the components hydrating it.
+var DevelopmentMode = false
+
+// Hydrate renders comp into container like Render, but instead of creating new
+// DOM nodes it adopts the nodes already present in container, as written by
+// RenderHTML on the server, and attaches event listeners to them.
+//
+// Where the existing DOM does not match the components, the mismatching nodes
+// are replaced by newly rendered ones, and differing properties, attributes,
+// styles and data of adopted elements are corrected. If DevelopmentMode is
+// enabled, every mismatch is reported on the browser console.
+//
+// The options are the same as for Render.
+func Hydrate(comp Component, container *js.Object, opts ...RenderOption) *Root {
+	r := &Root{
+		comp:       comp,
+		container:  container,
+		delegation: newDelegationRoot(comp, container, opts),
+	}
+	withDelegation(r.delegation, func() {
+		reconcile(func() {
+			hydrate(comp, container, skipComments(dom.FirstChild(container)))
+			mount(comp)
+		})
+	})
+	return r
+}
+
+// hydrate adopts node, which may be nil, as the node of comp within parent and
+// returns the next sibling to be adopted.
+func hydrate(comp Component, parent, node *js.Object) *js.Object {
+	switch c := comp.(type) {
+	case *textComponent:
+		if c.text == "" {
+			// Empty text does not survive a round trip through HTML.
+			c.Reconcile(nil)
+			dom.InsertBefore(parent, c.node, node)
+			return node
+		}
+		if node == nil || dom.NodeName(node) != "#text" {
+			return hydrateMismatch(comp, parent, node, fmt.Sprintf("expected text %q", c.text))
+		}
+		c.node = node
+		if value := dom.NodeValue(node); value != c.text {
+			reportMismatch(fmt.Sprintf("expected text %q, found %q", c.text, value))
+			dom.SetNodeValue(node, c.text)
+		}
+		return skipComments(dom.NextSibling(node))
+
+	case *Element:
+		c.inheritNamespace(parentNamespace)
+		c.propertiesToAttributes()
+		c.checkUnsafeHTML()
+		if node == nil || !strings.EqualFold(dom.NodeName(node), c.TagName) {
+			return hydrateMismatch(comp, parent, node, fmt.Sprintf("expected element <%s>", c.TagName))
+		}
+		c.node = node
+
+		if c.UnsafeHTML != "" && !sameInnerHTML(node, c.UnsafeHTML) {
+			reportMismatch(fmt.Sprintf("unsafe HTML of <%s> differs", c.TagName))
+			dom.SetProperty(node, "innerHTML", c.UnsafeHTML)
+		}
+		prevNamespace := parentNamespace
+		parentNamespace = c.childNamespace()
+		child := skipComments(dom.FirstChild(node))
+		for _, cc := range c.Children {
+			child = hydrate(cc, node, child)
+		}
+		parentNamespace = prevNamespace
+		_, hasValue := c.Properties["value"]
+		for child != nil && c.UnsafeHTML == "" {
+			next := skipComments(dom.NextSibling(child))
+			if !(c.TagName == "textarea" && hasValue) {
+				reportMismatch(fmt.Sprintf("unexpected node %s in <%s>", dom.NodeName(child), c.TagName))
+			}
+			removeNode(child)
+			child = next
+		}
+
+		c.hydrateMarkup()
+		c.reconcileListeners(nil)
+		c.setRefs(nil)
+		return skipComments(dom.NextSibling(node))
+
+	case *fragment:
+		for _, cc := range c.children {
+			node = hydrate(cc, parent, node)
+		}
+		if len(childNodes(c.children)) == 0 {
+			// The placeholder of an empty fragment is not part of the HTML.
+			c.placeholder = dom.CreateTextNode("")
+			dom.InsertBefore(parent, c.placeholder, node)
+		}
+		return node
+
+	case *portal:
+		// The children of a portal are not part of the markup, so they are
+		// rendered from scratch.
+		c.Reconcile(nil)
+		dom.InsertBefore(parent, c.placeholder, node)
+		return node
+
+	case compositeComponent:
+		cc := c.composite()
+		if rendering != nil {
+			cc.parent = rendering
+		}
+		cc.delegation = delegating
+		cc.namespace = parentNamespace
+		cc.static = true
+		reconcileComponent(comp, nil)
+		cc.static = false
+		prevRendering := rendering
+		rendering = cc
+		defer func() {
+			rendering = prevRendering
+		}()
+		if b := cc.boundary; b != nil && !b.failed {
+			return hydrateBoundaryChild(b, parent, node)
+		}
+		return hydrate(cc.Body, parent, node)
+	}
+
+	return hydrateMismatch(comp, parent, node, fmt.Sprintf("cannot hydrate component of type %T", comp))
+}
+
+// hydrateMarkup makes the properties, attributes, styles and data of the
+// adopted node of e match e, reporting every difference.
+func (e *Element) hydrateMarkup() {
+	for name, value := range e.Properties {
+		if !sameLiveProperty(value, dom.Property(e.node, name)) {
+			reportMismatch(fmt.Sprintf("property %s of <%s> differs", name, e.TagName))
+			dom.SetProperty(e.node, name, value)
+		}
+	}
+	for name, value := range e.Attributes {
+		if v, ok := dom.Attribute(e.node, name); !ok || v != fmt.Sprint(value) {
+			reportMismatch(fmt.Sprintf("attribute %s of <%s> differs", name, e.TagName))
+			dom.SetAttribute(e.node, name, value)
+		}
+	}
+	for name, value := range e.Style {
+		if dom.Style(e.node, name) != fmt.Sprint(value) {
+			reportMismatch(fmt.Sprintf("style %s of <%s> differs", name, e.TagName))
+			dom.SetStyle(e.node, name, value)
+		}
+	}
+	for name, value := range e.Dataset {
+		if v, ok := dom.Data(e.node, name); !ok || v != value {
+			reportMismatch(fmt.Sprintf("data %s of <%s> differs", name, e.TagName))
+			dom.SetData(e.node, name, value)
+		}
+	}
+}
+
+// sameInnerHTML reports whether the inner HTML of node is html. The browser
+// normalizes the markup it parses, e.g. <br/> to <br>, so html is parsed and
+// serialized by an inert <template> element before it is compared.
+func sameInnerHTML(node *js.Object, html string) bool {
+	template := dom.CreateElement("template")
+	dom.SetProperty(template, "innerHTML", html)
+	return dom.Property(node, "innerHTML") == dom.Property(template, "innerHTML")
+}
+
+// hydrateBoundaryChild hydrates the child of the error boundary b like
+// hydrate. If hydrating the child panics, the fallback is hydrated in its
+// place instead.
+func hydrateBoundaryChild(b *ErrorBoundary, parent, node *js.Object) (next *js.Object) {
+	// The marker keeps the place of the child, whose first node may have been
+	// replaced by the time it panics.
+	marker := dom.CreateTextNode("")
+	dom.InsertBefore(parent, marker, node)
+	defer removeNode(marker)
+	child := b.Body
+	if b.catch(func() { next = hydrate(child, parent, node) }) {
+		return next
+	}
+	discardHydrated(child)
+	b.Body = b.render()
+	return hydrate(b.Body, parent, skipComments(dom.NextSibling(marker)))
+}
+
+// discardHydrated undoes what hydrating comp did besides adopting and
+// modifying nodes, which are left to be adopted by other components: it
+// removes the DOM event listeners of its elements and the nodes of its
+// portals.
+func discardHydrated(comp Component) {
+	switch c := comp.(type) {
+	case *Element:
+		for _, h := range c.handlers {
+			if h.remove != nil {
+				h.remove()
+			}
+		}
+		c.handlers = nil
+	case *portal:
+		if c.end != nil && dom.ParentNode(c.end) != nil {
+			c.remove()
+		}
+	}
+	for _, child := range childComponents(comp) {
+		discardHydrated(child)
+	}
+}
+
+// hydrateMismatch renders comp from scratch and puts it in the place of node.
+func hydrateMismatch(comp Component, parent, node *js.Object, msg string) *js.Object {
+	reportMismatch(msg)
+	comp.Reconcile(nil)
+	insertNodes(parent, componentNodes(comp), node)
+	if node == nil {
+		return nil
+	}
+	next := skipComments(dom.NextSibling(node))
+	removeNode(node)
+	return next
+}
+
+func reportMismatch(msg string) {
+	if DevelopmentMode {
+		dom.Warn("vecty: hydration mismatch: " + msg)
+	}
+}
+
+// skipComments returns the first node starting at node which is not a
+// comment, such as the separators written by RenderHTML between adjacent
+// text.
+func skipComments(node *js.Object) *js.Object {
+	for node != nil && dom.NodeName(node) == "#comment" {
+		node = dom.NextSibling(node)
+	}
+	return node
+}
@@ -0,0 +1,90 @@
+// +build !js
+
+package vecty_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/memdom"
+	"github.com/gopherjs/vecty/prop"
+)
+
+func TestHydrateMarkup(t *testing.T) {
+	doc := memdom.NewDocument()
+	defer vecty.SetDOM(vecty.SetDOM(doc))
+	vecty.DevelopmentMode = true
+	defer func() {
+		vecty.DevelopmentMode = false
+	}()
+
+	// A <div> as rendered on the server, but with one attribute only.
+	container := doc.CreateElement("body")
+	div := doc.CreateElement("div")
+	doc.InsertBefore(container, div, nil)
+	doc.SetAttribute(div, "role", "note")
+	doc.ResetOps()
+
+	vecty.Hydrate(elem.Div(
+		prop.ID("a"),
+		vecty.Attribute("role", "note"),
+		vecty.Style("color", "red"),
+		vecty.Data("x", "1"),
+	), container)
+
+	n := doc.Node(div)
+	if n.Properties["id"] != "a" || n.Style["color"] != "red" || n.Dataset["x"] != "1" {
+		t.Errorf("got properties %v, style %v and data %v", n.Properties, n.Style, n.Dataset)
+	}
+	want := []string{
+		`setProperty #2 <div> id "a"`,
+		`setStyle #2 <div> color red`,
+		`setData #2 <div> x "1"`,
+	}
+	if got := doc.Ops(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got ops\n%q\nwant\n%q", got, want)
+	}
+	if got := doc.Warnings(); len(got) != 3 {
+		t.Errorf("got warnings %q, want one for each of the 3 differences", got)
+	}
+}
+
+func TestHydrateUnsafeHTML(t *testing.T) {
+	doc := memdom.NewDocument()
+	defer vecty.SetDOM(vecty.SetDOM(doc))
+	vecty.DevelopmentMode = true
+	defer func() {
+		vecty.DevelopmentMode = false
+	}()
+
+	container := doc.CreateElement("body")
+	for _, html := range []string{"<b>a</b>", "<b>b</b>"} {
+		div := doc.CreateElement("div")
+		doc.InsertBefore(container, div, nil)
+		doc.SetProperty(div, "innerHTML", html)
+	}
+	doc.ResetOps()
+
+	vecty.Hydrate(vecty.Fragment(
+		elem.Div(vecty.UnsafeHTML("<b>a</b>")),
+		elem.Div(vecty.UnsafeHTML("<i>b</i>")),
+	), container)
+
+	// The inner HTML of the elements is compared after a round trip through a
+	// <template>, and only replaced where it differs.
+	want := []string{
+		`createElement #4 <template>`,
+		`setProperty #4 <template> innerHTML "<b>a</b>"`,
+		`createElement #5 <template>`,
+		`setProperty #5 <template> innerHTML "<i>b</i>"`,
+		`setProperty #3 <div> innerHTML "<i>b</i>"`,
+	}
+	if got := doc.Ops(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got ops\n%q\nwant\n%q", got, want)
+	}
+	if got := doc.Warnings(); len(got) != 1 {
+		t.Errorf("got warnings %q, want one for the differing HTML", got)
+	}
+}
@@ -0,0 +1,157 @@
+package vecty
+
+import "github.com/gopherjs/gopherjs/js"
+
+// jsDOM implements the DOM interface using the browser's DOM.
+type jsDOM struct{}
+
+func (jsDOM) Document() *js.Object {
+	return js.Global.Get("document")
+}
+
+func (d jsDOM) Head() *js.Object {
+	return d.Document().Get("head")
+}
+
+func (d jsDOM) SetBody(body *js.Object) {
+	d.Document().Set("body", body)
+}
+
+func (d jsDOM) CreateElement(tagName string) *js.Object {
+	return d.Document().Call("createElement", tagName)
+}
+
+func (d jsDOM) CreateElementNS(namespace, tagName string) *js.Object {
+	return d.Document().Call("createElementNS", namespace, tagName)
+}
+
+func (d jsDOM) CreateTextNode(text string) *js.Object {
+	return d.Document().Call("createTextNode", text)
+}
+
+func (jsDOM) NodeName(node *js.Object) string {
+	return node.Get("nodeName").String()
+}
+
+func (jsDOM) NodeValue(node *js.Object) string {
+	return node.Get("nodeValue").String()
+}
+
+func (jsDOM) SetNodeValue(node *js.Object, text string) {
+	node.Set("nodeValue", text)
+}
+
+func (jsDOM) Property(node *js.Object, name string) interface{} {
+	return node.Get(name).Interface()
+}
+
+func (jsDOM) ObjectProperty(node *js.Object, name string) *js.Object {
+	value := node.Get(name)
+	if value == js.Undefined {
+		return nil
+	}
+	return value
+}
+
+func (jsDOM) SetProperty(node *js.Object, name string, value interface{}) {
+	node.Set(name, value)
+}
+
+func (jsDOM) Attribute(node *js.Object, name string) (string, bool) {
+	value := node.Call("getAttribute", name)
+	if value == nil {
+		return "", false
+	}
+	return value.String(), true
+}
+
+func (jsDOM) SetAttribute(node *js.Object, name string, value interface{}) {
+	node.Call("setAttribute", name, value)
+}
+
+func (jsDOM) RemoveAttribute(node *js.Object, name string) {
+	node.Call("removeAttribute", name)
+}
+
+func (jsDOM) Style(node *js.Object, name string) string {
+	return node.Get("style").Call("getPropertyValue", name).String()
+}
+
+func (jsDOM) SetStyle(node *js.Object, name string, value interface{}) {
+	node.Get("style").Call("setProperty", name, value)
+}
+
+func (jsDOM) RemoveStyle(node *js.Object, name string) {
+	node.Get("style").Call("removeProperty", name)
+}
+
+func (jsDOM) Data(node *js.Object, name string) (string, bool) {
+	value := node.Get("dataset").Get(name)
+	if value == js.Undefined {
+		return "", false
+	}
+	return value.String(), true
+}
+
+func (jsDOM) SetData(node *js.Object, name, value string) {
+	node.Get("dataset").Set(name, value)
+}
+
+func (jsDOM) RemoveData(node *js.Object, name string) {
+	node.Get("dataset").Delete(name)
+}
+
+func (jsDOM) AddEventListener(node *js.Object, name string, options ListenerOptions, listener func(event *js.Object)) func() {
+	node.Call("addEventListener", name, listener, map[string]interface{}{
+		"capture": options.Capture,
+		"passive": options.Passive,
+		"once":    options.Once,
+	})
+	return func() {
+		node.Call("removeEventListener", name, listener, options.Capture)
+	}
+}
+
+func (jsDOM) InsertBefore(parent, node, ref *js.Object) {
+	parent.Call("insertBefore", node, ref)
+}
+
+func (jsDOM) ReplaceChild(parent, newChild, oldChild *js.Object) {
+	parent.Call("replaceChild", newChild, oldChild)
+}
+
+func (jsDOM) RemoveChild(parent, child *js.Object) {
+	parent.Call("removeChild", child)
+}
+
+func (jsDOM) ParentNode(node *js.Object) *js.Object {
+	return node.Get("parentNode")
+}
+
+func (jsDOM) FirstChild(node *js.Object) *js.Object {
+	return node.Get("firstChild")
+}
+
+func (jsDOM) NextSibling(node *js.Object) *js.Object {
+	return node.Get("nextSibling")
+}
+
+func (jsDOM) EventTarget(event *js.Object) *js.Object {
+	return event.Get("target")
+}
+
+func (jsDOM) PreventDefault(event *js.Object) {
+	event.Call("preventDefault")
+}
+
+func (jsDOM) StopPropagation(event *js.Object) {
+	event.Call("stopPropagation")
+}
+
+func (jsDOM) RequestAnimationFrame(callback func()) {
+	js.Global.Call("requestAnimationFrame", callback)
+}
+
+func (jsDOM) Warn(message string) {
+	js.Global.Get("console").Call("warn", message)
+}
@@ -2,6 +2,7 @@ package vecty
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/gopherjs/gopherjs/js"
@@ -37,6 +38,29 @@ func Property(name string, value interface{}) Markup {
 	return &property{Name: name, Value: value}
 }
 
+type attribute struct {
+	Name  string
+	Value interface{}
+}
+
+// Apply implements the Markup interface.
+func (a *attribute) Apply(element *Element) {
+	if element.Attributes == nil {
+		element.Attributes = make(map[string]interface{})
+	}
+	if _, ok := element.Attributes[a.Name]; ok {
+		panic(fmt.Sprintf("duplicate attribute: %s", a.Name))
+	}
+	element.Attributes[a.Name] = a.Value
+}
+
+// Attribute returns Markup which sets the named attribute of a DOM element to
+// the given value. Unlike Property, it can set attributes which are not
+// reflected by a property, such as "aria-*" and "role".
+func Attribute(name string, value interface{}) Markup {
+	return &attribute{Name: name, Value: value}
+}
+
 type data struct {
 	name  string
 	value string
@@ -59,6 +83,97 @@ func Data(name, value string) Markup {
 	return &data{name: name, value: value}
 }
 
+type unsafeHTML string
+
+// Apply implements the Markup interface.
+func (h unsafeHTML) Apply(element *Element) {
+	if element.UnsafeHTML != "" {
+		panic("duplicate unsafe HTML")
+	}
+	element.UnsafeHTML = string(h)
+	element.checkUnsafeHTML()
+}
+
+// UnsafeHTML returns Markup which sets the inner HTML of an element to the
+// given HTML, which is not escaped in any way. Feeding user input to it is
+// unsafe, as it allows cross-site scripting. The element must not have any
+// children besides the HTML.
+func UnsafeHTML(html string) Markup {
+	return unsafeHTML(html)
+}
+
+// ref is implemented by the markup which receives the node of an element.
+type ref interface {
+	Markup
+	setNode(node *js.Object)
+}
+
+func containsRef(refs []ref, r ref) bool {
+	for _, r2 := range refs {
+		if r2 == r {
+			return true
+		}
+	}
+	return false
+}
+
+// Ref is markup which refers to the DOM node of the element it is applied to,
+// e.g. to focus an input or to measure an element:
+//
+//  p.input = new(vecty.Ref)
+//  ...
+//  elem.Input(p.input)
+//  ...
+//  p.input.Node().Call("focus")
+//
+// The node is set once the element has been rendered into the DOM, before the
+// Mount and Updated methods of its components are called, and reset to nil
+// when the element is removed.
+type Ref struct {
+	node *js.Object
+}
+
+// Apply implements the Markup interface.
+func (r *Ref) Apply(element *Element) {
+	element.refs = append(element.refs, r)
+}
+
+func (r *Ref) setNode(node *js.Object) {
+	r.node = node
+}
+
+// Node returns the DOM node of the element the ref is applied to, or nil if
+// the element is not rendered.
+func (r *Ref) Node() *js.Object {
+	return r.node
+}
+
+type refFunc func(node *js.Object)
+
+// Apply implements the Markup interface.
+func (f *refFunc) Apply(element *Element) {
+	element.refs = append(element.refs, f)
+}
+
+func (f *refFunc) setNode(node *js.Object) {
+	(*f)(node)
+}
+
+// RefFunc returns markup which calls f with the DOM node of the element it is
+// applied to every time the element has been rendered into the DOM, and with
+// nil when the element is removed or no longer uses the markup, e.g. to
+// integrate third-party widgets.
+//
+// Every call to RefFunc returns distinct markup, so if the element is rendered
+// again with the markup of a new call, the function of the previous markup is
+// called with nil before the new one is called with the node. To have f called
+// with nil only when the element goes away, keep the markup across renders,
+// e.g. in a field of the component.
+func RefFunc(f func(node *js.Object)) Markup {
+	r := refFunc(f)
+	return &r
+}
+
 // ClassMap is markup that specifies classes to be applied to an element if
 // their boolean value are true.
 type ClassMap map[string]bool
@@ -71,6 +186,7 @@ func (m ClassMap) Apply(element *Element) {
 			classes = append(classes, name)
 		}
 	}
+	sort.Strings(classes) // keep the class name stable across renders
 	Property("className", strings.Join(classes, " ")).Apply(element)
 }
 
@@ -96,6 +212,27 @@ func Style(name string, value interface{}) Markup {
 	return &style{Name: name, Value: value}
 }
 
+type key struct {
+	value interface{}
+}
+
+// Apply implements the Markup interface.
+func (k *key) Apply(element *Element) {
+	if element.key != nil {
+		panic(fmt.Sprintf("duplicate key: %v", k.value))
+	}
+	element.key = k.value
+}
+
+// Key returns Markup which identifies an element among its siblings by the
+// given key, which must be comparable. When the parent element is
+// reconciled, a keyed child is matched with the old child of the same key
+// wherever it was positioned, so its DOM node is moved instead of rebuilt.
+// Unkeyed children are matched by their position.
+func Key(k interface{}) Markup {
+	return &key{value: k}
+}
+
 // EventListener is markup that specifies a callback function to be invoked when
 // the named DOM event is fired.
 type EventListener struct {
@@ -103,7 +240,7 @@ type EventListener struct {
 	Listener            func(*Event)
 	callPreventDefault  bool
 	callStopPropagation bool
-	wrapper             func(jsEvent *js.Object)
+	options             ListenerOptions
 }
 
 // PreventDefault prevents the default behavior of the event from occuring.
@@ -123,14 +260,57 @@ func (l *EventListener) StopPropagation() *EventListener {
 	return l
 }
 
+// Capture makes the listener be called in the capturing phase, before the
+// listeners of the target and of the elements in between, instead of the
+// bubbling phase.
+//
+// See https://developer.mozilla.org/en-US/docs/Web/API/EventTarget/addEventListener.
+func (l *EventListener) Capture() *EventListener {
+	l.options.Capture = true
+	return l
+}
+
+// Passive promises that the listener does not prevent the default behavior of
+// the event, which allows the browser e.g. to scroll without waiting for
+// scroll and touch listeners.
+//
+// See https://developer.mozilla.org/en-US/docs/Web/API/EventTarget/addEventListener.
+func (l *EventListener) Passive() *EventListener {
+	l.options.Passive = true
+	return l
+}
+
+// Once makes the listener be called at most once while its element keeps
+// listening with it across renders.
+//
+// See https://developer.mozilla.org/en-US/docs/Web/API/EventTarget/addEventListener.
+func (l *EventListener) Once() *EventListener {
+	l.options.Once = true
+	return l
+}
+
 // Apply implements the Markup interface.
 func (l *EventListener) Apply(element *Element) {
 	element.EventListeners = append(element.EventListeners, l)
 }
 
-// Event represents a DOM event.
-type Event struct {
-	Target *js.Object
+// handle calls the listener for the given DOM event, which is handled by the
+// element with the given node. It reports whether the propagation of the event
+// has been stopped.
+func (l *EventListener) handle(jsEvent, node *js.Object) (stopped bool) {
+	e := &Event{
+		Target:        dom.EventTarget(jsEvent),
+		Object:        jsEvent,
+		currentTarget: node,
+	}
+	if l.callPreventDefault {
+		e.PreventDefault()
+	}
+	if l.callStopPropagation {
+		e.StopPropagation()
+	}
+	l.Listener(e)
+	return e.propagationStopped
 }
 
 // List represents a list of markup which will all be applied to an element.
@@ -0,0 +1,596 @@
+// +build !js
+
+// Package memdom implements an in-memory document which can be used as the DOM
+// of Vecty under the standard Go toolchain, e.g. to test the reconciler or
+// components with go test:
+//
+//  doc := memdom.NewDocument()
+//  prev := vecty.SetDOM(doc)
+//  defer vecty.SetDOM(prev)
+//
+// Every operation which modifies the document is recorded, so that tests can
+// assert on the exact DOM operations performed by a reconcile.
+package memdom
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/gopherjs/vecty"
+)
+
+// Node is a node of a Document.
+type Node struct {
+	// Name is the tag name of an element, uppercase for HTML elements, or
+	// "#text" for a text node.
+	Name string
+
+	// Namespace is the namespace of an element which is not an HTML element.
+	Namespace string
+
+	// Value is the text of a text node.
+	Value string
+
+	Properties map[string]interface{}
+	Attributes map[string]string
+	Style      map[string]interface{}
+	Dataset    map[string]string
+	Parent     *Node
+	Children   []*Node
+
+	id        int
+	handle    *js.Object
+	listeners []*listener
+}
+
+// Handle returns the handle by which Vecty refers to the node.
+func (n *Node) Handle() *js.Object {
+	return n.handle
+}
+
+// TagName returns the tag name of an element node as used in markup, i.e.
+// lowercase for HTML elements.
+func (n *Node) TagName() string {
+	if n.Namespace != "" {
+		return n.Name
+	}
+	return strings.ToLower(n.Name)
+}
+
+// Text returns the concatenated text of the node and all of its descendants.
+func (n *Node) Text() string {
+	if n.Name == "#text" {
+		return n.Value
+	}
+	var text string
+	for _, c := range n.Children {
+		text += c.Text()
+	}
+	return text
+}
+
+func (n *Node) String() string {
+	if n == nil {
+		return "<nil>"
+	}
+	if n.Name == "#text" {
+		return fmt.Sprintf("#%d %q", n.id, n.Value)
+	}
+	return fmt.Sprintf("#%d <%s>", n.id, n.TagName())
+}
+
+func (n *Node) indexOf(child *Node) int {
+	for i, c := range n.Children {
+		if c == child {
+			return i
+		}
+	}
+	return -1
+}
+
+type listener struct {
+	name    string
+	options vecty.ListenerOptions
+	f       func(event *js.Object)
+}
+
+// Document is an in-memory implementation of the vecty.DOM interface.
+type Document struct {
+	nodes    map[*js.Object]*Node
+	events   map[*js.Object]*Event
+	nextID   int
+	ops      []string
+	frames   []func()
+	doc      *Node
+	warnings []string
+}
+
+// NewDocument returns an empty document.
+func NewDocument() *Document {
+	return &Document{
+		nodes:  make(map[*js.Object]*Node),
+		events: make(map[*js.Object]*Event),
+	}
+}
+
+// Node returns the node with the given handle, or nil if the handle does not
+// belong to a node of the document.
+func (d *Document) Node(handle *js.Object) *Node {
+	return d.nodes[handle]
+}
+
+// Ops returns the operations performed on the document since it was created or
+// since the last call to ResetOps, e.g. `setProperty #1 <input> value "foo"`.
+func (d *Document) Ops() []string {
+	return d.ops
+}
+
+// ResetOps clears the recorded operations.
+func (d *Document) ResetOps() {
+	d.ops = nil
+}
+
+// Warnings returns the warnings reported through Warn.
+func (d *Document) Warnings() []string {
+	return d.warnings
+}
+
+func (d *Document) record(op string, args ...interface{}) {
+	d.ops = append(d.ops, fmt.Sprintf(op, args...))
+}
+
+func (d *Document) newNode(name string) *Node {
+	d.nextID++
+	n := &Node{
+		Name:       name,
+		Properties: make(map[string]interface{}),
+		Attributes: make(map[string]string),
+		Style:      make(map[string]interface{}),
+		Dataset:    make(map[string]string),
+		id:         d.nextID,
+		handle:     new(js.Object),
+	}
+	d.nodes[n.handle] = n
+	return n
+}
+
+func (d *Document) node(handle *js.Object) *Node {
+	n, ok := d.nodes[handle]
+	if !ok {
+		panic("memdom: unknown node")
+	}
+	return n
+}
+
+// document returns the document node, creating it along with an <html>
+// element containing <head> and <body> on first use.
+func (d *Document) document() *Node {
+	if d.doc != nil {
+		return d.doc
+	}
+	d.doc = d.newNode("#document")
+	d.doc.Properties["readyState"] = "complete"
+	html := d.newNode("HTML")
+	for _, n := range []*Node{d.newNode("HEAD"), d.newNode("BODY")} {
+		n.Parent = html
+		html.Children = append(html.Children, n)
+	}
+	html.Parent = d.doc
+	d.doc.Children = []*Node{html}
+	return d.doc
+}
+
+// Document implements the vecty.DOM interface. The readyState property of the
+// document is "complete".
+func (d *Document) Document() *js.Object {
+	return d.document().handle
+}
+
+// Head implements the vecty.DOM interface.
+func (d *Document) Head() *js.Object {
+	return d.document().Children[0].Children[0].handle
+}
+
+// Body returns the body element of the document.
+func (d *Document) Body() *Node {
+	return d.document().Children[0].Children[1]
+}
+
+// Focus makes n the activeElement of the document, as if the user had focused
+// it. No events are dispatched.
+func (d *Document) Focus(n *Node) {
+	d.document().Properties["activeElement"] = n
+}
+
+// SetBody implements the vecty.DOM interface.
+func (d *Document) SetBody(body *js.Object) {
+	old, n := d.Body(), d.node(body)
+	d.ReplaceChild(old.Parent.handle, n.handle, old.handle)
+}
+
+// CreateElement implements the vecty.DOM interface.
+func (d *Document) CreateElement(tagName string) *js.Object {
+	n := d.newNode(strings.ToUpper(tagName))
+	d.record("createElement %s", n)
+	return n.handle
+}
+
+// CreateElementNS implements the vecty.DOM interface.
+func (d *Document) CreateElementNS(namespace, tagName string) *js.Object {
+	n := d.newNode(tagName)
+	n.Namespace = namespace
+	d.record("createElementNS %s %s", n, namespace)
+	return n.handle
+}
+
+// CreateTextNode implements the vecty.DOM interface.
+func (d *Document) CreateTextNode(text string) *js.Object {
+	n := d.newNode("#text")
+	n.Value = text
+	d.record("createTextNode %s", n)
+	return n.handle
+}
+
+// NodeName implements the vecty.DOM interface.
+func (d *Document) NodeName(node *js.Object) string {
+	return d.node(node).Name
+}
+
+// NodeValue implements the vecty.DOM interface.
+func (d *Document) NodeValue(node *js.Object) string {
+	return d.node(node).Value
+}
+
+// SetNodeValue implements the vecty.DOM interface.
+func (d *Document) SetNodeValue(node *js.Object, text string) {
+	n := d.node(node)
+	n.Value = text
+	d.record("setNodeValue %s", n)
+}
+
+// Property implements the vecty.DOM interface. The properties of an event are
+// its Properties, and its Name as "type".
+func (d *Document) Property(node *js.Object, name string) interface{} {
+	if e, ok := d.events[node]; ok {
+		if name == "type" {
+			return e.Name
+		}
+		return e.Properties[name]
+	}
+	return d.node(node).Properties[name]
+}
+
+// ObjectProperty implements the vecty.DOM interface. Properties of nodes and
+// events which are nodes of the document are returned as their handles.
+func (d *Document) ObjectProperty(node *js.Object, name string) *js.Object {
+	var value interface{}
+	if e, ok := d.events[node]; ok {
+		value = e.Properties[name]
+	} else {
+		value = d.node(node).Properties[name]
+	}
+	switch v := value.(type) {
+	case *Node:
+		return v.handle
+	case *js.Object:
+		return v
+	}
+	return nil
+}
+
+// SetProperty implements the vecty.DOM interface. Setting a property to nil
+// deletes it.
+func (d *Document) SetProperty(node *js.Object, name string, value interface{}) {
+	n := d.node(node)
+	if value == nil {
+		delete(n.Properties, name)
+	} else {
+		n.Properties[name] = value
+	}
+	d.record("setProperty %s %s %#v", n, name, value)
+}
+
+// Attribute implements the vecty.DOM interface.
+func (d *Document) Attribute(node *js.Object, name string) (string, bool) {
+	value, ok := d.node(node).Attributes[name]
+	return value, ok
+}
+
+// SetAttribute implements the vecty.DOM interface. Like the browser, it
+// converts the value to a string.
+func (d *Document) SetAttribute(node *js.Object, name string, value interface{}) {
+	n := d.node(node)
+	n.Attributes[name] = fmt.Sprint(value)
+	d.record("setAttribute %s %s %q", n, name, n.Attributes[name])
+}
+
+// RemoveAttribute implements the vecty.DOM interface.
+func (d *Document) RemoveAttribute(node *js.Object, name string) {
+	n := d.node(node)
+	delete(n.Attributes, name)
+	d.record("removeAttribute %s %s", n, name)
+}
+
+// Style implements the vecty.DOM interface. Like the browser, it converts the
+// value to a string.
+func (d *Document) Style(node *js.Object, name string) string {
+	value, ok := d.node(node).Style[name]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// SetStyle implements the vecty.DOM interface.
+func (d *Document) SetStyle(node *js.Object, name string, value interface{}) {
+	n := d.node(node)
+	n.Style[name] = value
+	d.record("setStyle %s %s %v", n, name, value)
+}
+
+// RemoveStyle implements the vecty.DOM interface.
+func (d *Document) RemoveStyle(node *js.Object, name string) {
+	n := d.node(node)
+	delete(n.Style, name)
+	d.record("removeStyle %s %s", n, name)
+}
+
+// Data implements the vecty.DOM interface.
+func (d *Document) Data(node *js.Object, name string) (string, bool) {
+	value, ok := d.node(node).Dataset[name]
+	return value, ok
+}
+
+// SetData implements the vecty.DOM interface.
+func (d *Document) SetData(node *js.Object, name, value string) {
+	n := d.node(node)
+	n.Dataset[name] = value
+	d.record("setData %s %s %q", n, name, value)
+}
+
+// RemoveData implements the vecty.DOM interface.
+func (d *Document) RemoveData(node *js.Object, name string) {
+	n := d.node(node)
+	delete(n.Dataset, name)
+	d.record("removeData %s %s", n, name)
+}
+
+// AddEventListener implements the vecty.DOM interface.
+func (d *Document) AddEventListener(node *js.Object, name string, options vecty.ListenerOptions, f func(event *js.Object)) func() {
+	n := d.node(node)
+	l := &listener{name: name, options: options, f: f}
+	n.listeners = append(n.listeners, l)
+	d.record("addEventListener %s %s%s", n, name, formatOptions(options))
+	return func() {
+		if n.removeListener(l) {
+			d.record("removeEventListener %s %s%s", n, name, formatOptions(options))
+		}
+	}
+}
+
+func (n *Node) removeListener(l *listener) bool {
+	for i, l2 := range n.listeners {
+		if l2 == l {
+			n.listeners = append(n.listeners[:i], n.listeners[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func formatOptions(options vecty.ListenerOptions) string {
+	var s string
+	if options.Capture {
+		s += " capture"
+	}
+	if options.Passive {
+		s += " passive"
+	}
+	if options.Once {
+		s += " once"
+	}
+	return s
+}
+
+// InsertBefore implements the vecty.DOM interface.
+func (d *Document) InsertBefore(parent, node, ref *js.Object) {
+	p, n := d.node(parent), d.node(node)
+	d.detach(n)
+	i := len(p.Children)
+	if ref != nil {
+		i = p.indexOf(d.node(ref))
+		if i == -1 {
+			panic("memdom: reference node is not a child of parent")
+		}
+	}
+	p.Children = append(p.Children, nil)
+	copy(p.Children[i+1:], p.Children[i:])
+	p.Children[i] = n
+	n.Parent = p
+	d.record("insertBefore %s %s %s", p, n, d.nodes[ref])
+}
+
+// ReplaceChild implements the vecty.DOM interface.
+func (d *Document) ReplaceChild(parent, newChild, oldChild *js.Object) {
+	p, n, o := d.node(parent), d.node(newChild), d.node(oldChild)
+	if o.Parent != p {
+		panic("memdom: node to be replaced is not a child of parent")
+	}
+	d.detach(n)
+	p.Children[p.indexOf(o)] = n
+	n.Parent = p
+	o.Parent = nil
+	d.record("replaceChild %s %s %s", p, n, o)
+}
+
+// RemoveChild implements the vecty.DOM interface.
+func (d *Document) RemoveChild(parent, child *js.Object) {
+	p, c := d.node(parent), d.node(child)
+	if c.Parent != p {
+		panic("memdom: node to be removed is not a child of parent")
+	}
+	d.detach(c)
+	d.record("removeChild %s %s", p, c)
+}
+
+func (d *Document) detach(n *Node) {
+	if n.Parent == nil {
+		return
+	}
+	p := n.Parent
+	i := p.indexOf(n)
+	p.Children = append(p.Children[:i], p.Children[i+1:]...)
+	n.Parent = nil
+}
+
+// ParentNode implements the vecty.DOM interface.
+func (d *Document) ParentNode(node *js.Object) *js.Object {
+	if p := d.node(node).Parent; p != nil {
+		return p.handle
+	}
+	return nil
+}
+
+// FirstChild implements the vecty.DOM interface.
+func (d *Document) FirstChild(node *js.Object) *js.Object {
+	if n := d.node(node); len(n.Children) != 0 {
+		return n.Children[0].handle
+	}
+	return nil
+}
+
+// NextSibling implements the vecty.DOM interface.
+func (d *Document) NextSibling(node *js.Object) *js.Object {
+	n := d.node(node)
+	if n.Parent == nil {
+		return nil
+	}
+	if i := n.Parent.indexOf(n); i+1 < len(n.Parent.Children) {
+		return n.Parent.Children[i+1].handle
+	}
+	return nil
+}
+
+// Event is an event dispatched on a Document.
+type Event struct {
+	Name   string
+	Target *Node
+
+	// Properties are the properties of the event besides its type and
+	// target, e.g. "key" for keyboard events.
+	Properties map[string]interface{}
+
+	defaultPrevented   bool
+	propagationStopped bool
+
+	// passive is set while a passive listener is called.
+	passive bool
+}
+
+// DefaultPrevented reports whether a listener prevented the default action of
+// the event.
+func (e *Event) DefaultPrevented() bool {
+	return e.defaultPrevented
+}
+
+func (d *Document) event(handle *js.Object) *Event {
+	e, ok := d.events[handle]
+	if !ok {
+		panic("memdom: unknown event")
+	}
+	return e
+}
+
+// EventTarget implements the vecty.DOM interface.
+func (d *Document) EventTarget(event *js.Object) *js.Object {
+	return d.event(event).Target.handle
+}
+
+// PreventDefault implements the vecty.DOM interface. Like the browser, it is
+// ignored when called by a passive listener.
+func (d *Document) PreventDefault(event *js.Object) {
+	if e := d.event(event); !e.passive {
+		e.defaultPrevented = true
+	}
+}
+
+// StopPropagation implements the vecty.DOM interface.
+func (d *Document) StopPropagation(event *js.Object) {
+	d.event(event).propagationStopped = true
+}
+
+// Dispatch dispatches an event of the given name to target. Like in the
+// browser, the event is first captured from the root of target down to target
+// and then bubbles up to the root again, calling the capturing and the other
+// listeners registered for it on every node respectively, unless a listener
+// stops its propagation.
+func (d *Document) Dispatch(target *Node, name string) *Event {
+	return d.DispatchEvent(target, &Event{Name: name})
+}
+
+// DispatchEvent is like Dispatch, but dispatches the given event, e.g. one
+// with properties.
+func (d *Document) DispatchEvent(target *Node, e *Event) *Event {
+	e.Target = target
+	handle := new(js.Object)
+	d.events[handle] = e
+	defer delete(d.events, handle)
+
+	var path []*Node
+	for n := target; n != nil; n = n.Parent {
+		path = append(path, n)
+	}
+	for i := len(path) - 1; i > 0 && !e.propagationStopped; i-- {
+		d.callListeners(path[i], e, handle, true)
+	}
+	if !e.propagationStopped {
+		d.callListeners(target, e, handle, true)
+		d.callListeners(target, e, handle, false)
+	}
+	for _, n := range path[1:] {
+		if e.propagationStopped {
+			break
+		}
+		d.callListeners(n, e, handle, false)
+	}
+	return e
+}
+
+// callListeners calls the capturing or the other listeners of n for e.
+func (d *Document) callListeners(n *Node, e *Event, handle *js.Object, capture bool) {
+	listeners := append([]*listener(nil), n.listeners...)
+	for _, l := range listeners {
+		if l.name != e.Name || l.options.Capture != capture {
+			continue
+		}
+		if l.options.Once && n.removeListener(l) {
+			d.record("removeEventListener %s %s%s", n, l.name, formatOptions(l.options))
+		}
+		e.passive = l.options.Passive
+		l.f(handle)
+		e.passive = false
+	}
+}
+
+// RequestAnimationFrame implements the vecty.DOM interface. The callback is
+// called by the next call to AnimationFrame.
+func (d *Document) RequestAnimationFrame(callback func()) {
+	d.frames = append(d.frames, callback)
+}
+
+// Warn implements the vecty.DOM interface. The warnings are recorded, see
+// Warnings.
+func (d *Document) Warn(message string) {
+	d.warnings = append(d.warnings, message)
+}
+
+// AnimationFrame calls the callbacks which have been requested by
+// RequestAnimationFrame so far.
+func (d *Document) AnimationFrame() {
+	frames := d.frames
+	d.frames = nil
+	for _, f := range frames {
+		f()
+	}
+}
@@ -0,0 +1,226 @@
+// +build !js
+
+package memdom_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/memdom"
+)
+
+func TestOps(t *testing.T) {
+	d := memdom.NewDocument()
+	ul := d.CreateElement("ul")
+	li := d.CreateElement("li")
+	text := d.CreateTextNode("a")
+	d.InsertBefore(li, text, nil)
+	d.InsertBefore(ul, li, nil)
+	d.SetProperty(li, "className", "item")
+	d.SetAttribute(li, "aria-selected", true)
+	d.SetStyle(li, "color", "red")
+	d.SetData(li, "id", "1")
+	d.SetNodeValue(text, "b")
+	d.RemoveAttribute(li, "aria-selected")
+	d.RemoveStyle(li, "color")
+	d.RemoveData(li, "id")
+	d.SetProperty(li, "className", nil)
+
+	want := []string{
+		`createElement #1 <ul>`,
+		`createElement #2 <li>`,
+		`createTextNode #3 "a"`,
+		`insertBefore #2 <li> #3 "a" <nil>`,
+		`insertBefore #1 <ul> #2 <li> <nil>`,
+		`setProperty #2 <li> className "item"`,
+		`setAttribute #2 <li> aria-selected "true"`,
+		`setStyle #2 <li> color red`,
+		`setData #2 <li> id "1"`,
+		`setNodeValue #3 "b"`,
+		`removeAttribute #2 <li> aria-selected`,
+		`removeStyle #2 <li> color`,
+		`removeData #2 <li> id`,
+		`setProperty #2 <li> className <nil>`,
+	}
+	if got := d.Ops(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got ops\n%q\nwant\n%q", got, want)
+	}
+
+	n := d.Node(li)
+	if len(n.Properties) != 0 || len(n.Attributes) != 0 || len(n.Style) != 0 || len(n.Dataset) != 0 {
+		t.Errorf("got %v %v %v %v, want an element without markup", n.Properties, n.Attributes, n.Style, n.Dataset)
+	}
+	if got := d.Node(ul).Text(); got != "b" {
+		t.Errorf("got text %q, want %q", got, "b")
+	}
+
+	d.ResetOps()
+	if got := d.Ops(); len(got) != 0 {
+		t.Errorf("got ops %q after ResetOps, want none", got)
+	}
+}
+
+func TestTree(t *testing.T) {
+	d := memdom.NewDocument()
+	parent := d.CreateElement("div")
+	a, b, c := d.CreateElement("a"), d.CreateElement("b"), d.CreateElement("i")
+	d.InsertBefore(parent, a, nil)
+	d.InsertBefore(parent, b, nil)
+	d.InsertBefore(parent, c, a)
+	checkChildren(t, d, parent, c, a, b)
+
+	// Inserting a node which is already in the document moves it.
+	d.InsertBefore(parent, b, c)
+	checkChildren(t, d, parent, b, c, a)
+
+	d.ReplaceChild(parent, a, c)
+	checkChildren(t, d, parent, b, a)
+	if d.ParentNode(c) != nil {
+		t.Error("replaced node still has a parent")
+	}
+
+	d.RemoveChild(parent, b)
+	checkChildren(t, d, parent, a)
+	if d.FirstChild(parent) != a || d.NextSibling(a) != nil || d.ParentNode(a) != parent {
+		t.Error("wrong links between the remaining nodes")
+	}
+}
+
+func checkChildren(t *testing.T, d *memdom.Document, parent *js.Object, want ...*js.Object) {
+	t.Helper()
+	var got []*js.Object
+	for n := d.FirstChild(parent); n != nil; n = d.NextSibling(n) {
+		got = append(got, n)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got children %v, want %v", nodes(d, got), nodes(d, want))
+	}
+}
+
+func nodes(d *memdom.Document, handles []*js.Object) []*memdom.Node {
+	var nodes []*memdom.Node
+	for _, h := range handles {
+		nodes = append(nodes, d.Node(h))
+	}
+	return nodes
+}
+
+func TestDispatch(t *testing.T) {
+	d := memdom.NewDocument()
+	outer := d.CreateElement("div")
+	inner := d.CreateElement("button")
+	d.InsertBefore(outer, inner, nil)
+
+	var calls []string
+	listen := func(node *js.Object, name string, options vecty.ListenerOptions, f func(event *js.Object)) {
+		d.AddEventListener(node, "click", options, func(event *js.Object) {
+			calls = append(calls, name)
+			if f != nil {
+				f(event)
+			}
+		})
+	}
+	listen(outer, "outer", vecty.ListenerOptions{}, nil)
+	listen(outer, "outer-capture", vecty.ListenerOptions{Capture: true}, nil)
+	listen(inner, "inner-once", vecty.ListenerOptions{Once: true}, nil)
+	listen(inner, "inner-passive", vecty.ListenerOptions{Passive: true}, func(event *js.Object) {
+		d.PreventDefault(event) // ignored
+	})
+
+	e := d.Dispatch(d.Node(inner), "click")
+	want := []string{"outer-capture", "inner-once", "inner-passive", "outer"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("got calls %q, want %q", calls, want)
+	}
+	if e.DefaultPrevented() {
+		t.Error("passive listener prevented the default action")
+	}
+
+	calls = nil
+	listen(inner, "inner-stop", vecty.ListenerOptions{}, func(event *js.Object) {
+		if d.EventTarget(event) != inner || d.Property(event, "type") != "click" {
+			t.Error("wrong target or type of the event")
+		}
+		d.PreventDefault(event)
+		d.StopPropagation(event)
+	})
+	e = d.Dispatch(d.Node(inner), "click")
+	want = []string{"outer-capture", "inner-passive", "inner-stop"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("got calls %q, want %q", calls, want)
+	}
+	if !e.DefaultPrevented() {
+		t.Error("default action not prevented")
+	}
+}
+
+func TestRemoveEventListener(t *testing.T) {
+	d := memdom.NewDocument()
+	button := d.CreateElement("button")
+	calls := 0
+	remove := d.AddEventListener(button, "click", vecty.ListenerOptions{Passive: true}, func(*js.Object) {
+		calls++
+	})
+	d.Dispatch(d.Node(button), "click")
+	remove()
+	remove()
+	d.Dispatch(d.Node(button), "click")
+	if calls != 1 {
+		t.Errorf("got %d calls, want 1", calls)
+	}
+	want := []string{
+		`createElement #1 <button>`,
+		`addEventListener #1 <button> click passive`,
+		`removeEventListener #1 <button> click passive`,
+	}
+	if got := d.Ops(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got ops\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestDocument(t *testing.T) {
+	d := memdom.NewDocument()
+	if got := d.Property(d.Document(), "readyState"); got != "complete" {
+		t.Errorf("got readyState %v, want complete", got)
+	}
+	if got := d.Node(d.Head()).TagName(); got != "head" {
+		t.Errorf("got head <%s>", got)
+	}
+
+	body := d.CreateElement("body")
+	old := d.Body()
+	d.SetBody(body)
+	if d.Body() != d.Node(body) || old.Parent != nil {
+		t.Error("body not replaced")
+	}
+
+	d.Warn("a")
+	d.Warn("b")
+	if got, want := d.Warnings(), []string{"a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got warnings %q, want %q", got, want)
+	}
+}
+
+func TestAnimationFrame(t *testing.T) {
+	d := memdom.NewDocument()
+	var calls []int
+	d.RequestAnimationFrame(func() {
+		calls = append(calls, 1)
+		d.RequestAnimationFrame(func() {
+			calls = append(calls, 3)
+		})
+	})
+	d.RequestAnimationFrame(func() {
+		calls = append(calls, 2)
+	})
+	d.AnimationFrame()
+	if want := []int{1, 2}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("got calls %v after the first frame, want %v", calls, want)
+	}
+	d.AnimationFrame()
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("got calls %v after the second frame, want %v", calls, want)
+	}
+}
@@ -0,0 +1,84 @@
+package vecty
+
+import "github.com/gopherjs/gopherjs/js"
+
+type portal struct {
+	container *js.Object
+	children  []Component
+
+	// placeholder marks the place of the portal among its siblings, end marks
+	// the end of its children within the container.
+	placeholder *js.Object
+	end         *js.Object
+}
+
+// Portal returns a component which renders the given children into container,
+// e.g. document.body, instead of into the element the portal is a child of.
+// This allows modals, tooltips and dropdowns to escape the layout of their
+// ancestors:
+//
+//  body := js.Global.Get("document").Get("body")
+//  return elem.Div(
+//  	vecty.If(p.open, vecty.Portal(body, &Modal{})),
+//  )
+//
+// The children still belong to the component tree where the portal is placed:
+// they are reconciled, mounted and unmounted along with the portal, and their
+// nodes are removed from container when the portal is removed. Only
+// components, and markup resolving to components such as List and If, may be
+// passed to Portal.
+func Portal(container *js.Object, children ...Markup) Component {
+	return &portal{container: container, children: componentsOnly(children, "portal")}
+}
+
+// Apply implements the Markup interface.
+func (p *portal) Apply(element *Element) {
+	element.Children = append(element.Children, p)
+}
+
+func (p *portal) Reconcile(oldComp Component) {
+	// The events of the children do not bubble through the container of a
+	// delegation root, and the children do not inherit a namespace.
+	prevNamespace := parentNamespace
+	parentNamespace = ""
+	defer func() {
+		parentNamespace = prevNamespace
+	}()
+	withDelegation(nil, func() {
+		p.reconcile(oldComp)
+	})
+}
+
+func (p *portal) reconcile(oldComp Component) {
+	oldPortal, ok := oldComp.(*portal)
+	if !ok {
+		p.placeholder = dom.CreateTextNode("")
+		p.end = dom.CreateTextNode("")
+		for _, c := range p.children {
+			reconcileComponent(c, nil)
+		}
+		dom.InsertBefore(p.container, p.end, nil)
+		insertNodes(p.container, childNodes(p.children), p.end)
+		return
+	}
+
+	p.placeholder = oldPortal.placeholder
+	p.end = oldPortal.end
+	reconcileChildren(p.children, oldPortal.children)
+	if p.container != oldPortal.container {
+		dom.InsertBefore(p.container, p.end, nil)
+	}
+	placeNodes(p.container, childNodes(p.children), p.end)
+}
+
+// Node implements the Component interface. It returns an empty text node
+// marking the place of the portal.
+func (p *portal) Node() *js.Object {
+	return p.placeholder
+}
+
+// remove removes the nodes of the children from the container.
+func (p *portal) remove() {
+	removeNodes(childNodes(p.children))
+	removeNode(p.end)
+}
@@ -46,6 +46,10 @@ func Class(class string) vecty.Markup {
 	return vecty.Property("className", class)
 }
 
+func Disabled(disabled bool) vecty.Markup {
+	return vecty.Property("disabled", disabled)
+}
+
 func For(id string) vecty.Markup {
 	return vecty.Property("htmlFor", id)
 }
@@ -58,10 +62,26 @@ func ID(id string) vecty.Markup {
 	return vecty.Property("id", id)
 }
 
+func Indeterminate(indeterminate bool) vecty.Markup {
+	return vecty.Property("indeterminate", indeterminate)
+}
+
+func Multiple(multiple bool) vecty.Markup {
+	return vecty.Property("multiple", multiple)
+}
+
 func Placeholder(text string) vecty.Markup {
 	return vecty.Property("placeholder", text)
 }
 
+func ReadOnly(readOnly bool) vecty.Markup {
+	return vecty.Property("readOnly", readOnly)
+}
+
+func Selected(selected bool) vecty.Markup {
+	return vecty.Property("selected", selected)
+}
+
 func Src(url string) vecty.Markup {
 	return vecty.Property("src", url)
 }
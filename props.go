@@ -0,0 +1,66 @@
+package vecty
+
+import "reflect"
+
+var compositeType = reflect.TypeOf(Composite{})
+
+// PropsEqual reports whether a and b, which are expected to be pointers to
+// component structs, are of the same type and have equal exported fields,
+// their props. The embedded Composite and unexported fields, i.e. state, are
+// ignored. It is meant as the implementation of RenderSkipper for components
+// which only render their props:
+//
+//  func (b *Button) SkipRender(prev vecty.Component) bool {
+//  	return vecty.PropsEqual(b, prev)
+//  }
+//
+// Comparable fields are compared with ==, so pointers are equal if they point
+// to the same value, even if that value has been modified since. Other fields,
+// such as slices and maps, and fields containing interfaces, whose dynamic
+// values may not be comparable, are compared with reflect.DeepEqual.
+func PropsEqual(a, b Component) bool {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Type() != vb.Type() || va.Kind() != reflect.Ptr || va.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	if va.Pointer() == vb.Pointer() {
+		return true
+	}
+	va, vb = va.Elem(), vb.Elem()
+	t := va.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" || (f.Anonymous && f.Type == compositeType) {
+			continue
+		}
+		fa, fb := va.Field(i), vb.Field(i)
+		if f.Type.Comparable() && !containsInterface(f.Type) {
+			if fa.Interface() != fb.Interface() {
+				return false
+			}
+			continue
+		}
+		if !reflect.DeepEqual(fa.Interface(), fb.Interface()) {
+			return false
+		}
+	}
+	return true
+}
+
+// containsInterface reports whether values of type t contain interface values,
+// directly or in fields or elements of structs and arrays.
+func containsInterface(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Array:
+		return containsInterface(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if containsInterface(t.Field(i).Type) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+// +build !js
+
+package vecty_test
+
+import (
+	"testing"
+
+	"github.com/gopherjs/vecty"
+)
+
+type option struct {
+	Label string
+	Value interface{}
+}
+
+type picker struct {
+	vecty.Composite
+
+	Options  []option
+	Selected option
+	OnPick   *func(option)
+	state    int
+}
+
+func (p *picker) Apply(element *vecty.Element) {}
+
+func (p *picker) Reconcile(oldComp vecty.Component) {}
+
+func TestPropsEqual(t *testing.T) {
+	onPick := func(option) {}
+	tests := []struct {
+		name string
+		a, b *picker
+		want bool
+	}{
+		{
+			name: "equal",
+			a:    &picker{Options: []option{{"a", 1}}, Selected: option{"a", 1}, OnPick: &onPick},
+			b:    &picker{Options: []option{{"a", 1}}, Selected: option{"a", 1}, OnPick: &onPick},
+			want: true,
+		},
+		{
+			name: "state ignored",
+			a:    &picker{state: 1},
+			b:    &picker{state: 2},
+			want: true,
+		},
+		{
+			name: "different slice",
+			a:    &picker{Options: []option{{"a", 1}}},
+			b:    &picker{Options: []option{{"a", 2}}},
+			want: false,
+		},
+		{
+			name: "different pointer",
+			a:    &picker{OnPick: &onPick},
+			b:    &picker{OnPick: new(func(option))},
+			want: false,
+		},
+		{
+			name: "uncomparable value in struct",
+			a:    &picker{Selected: option{"a", []int{1}}},
+			b:    &picker{Selected: option{"a", []int{1}}},
+			want: true,
+		},
+		{
+			name: "different uncomparable value in struct",
+			a:    &picker{Selected: option{"a", []int{1}}},
+			b:    &picker{Selected: option{"a", []int{2}}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		if got := vecty.PropsEqual(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package vecty
+
+import (
+	"fmt"
+	"sort"
+)
+
+var (
+	// dirtyComposites are the composites scheduled by Rerender.
+	dirtyComposites []*Composite
+
+	// frameRequested is set while an animation frame for Flush is pending.
+	frameRequested bool
+)
+
+// Rerender schedules comp, which must embed Composite, to render its body
+// again. All composites scheduled before the next animation frame are rendered
+// together, parents before their children, and each at most once, no matter
+// how often Rerender was called for it. A composite whose parent is rendered
+// again is skipped, as it is replaced by its parent's new body.
+//
+// Use Flush to render the scheduled composites right away, e.g. to focus an
+// element which is only created by the rerender.
+func Rerender(comp Component) {
+	cc, ok := comp.(compositeComponent)
+	if !ok {
+		panic(fmt.Sprintf("vecty: Rerender called on %T, which does not embed Composite", comp))
+	}
+	c := cc.composite()
+	if c.dirty {
+		return
+	}
+	c.dirty = true
+	dirtyComposites = append(dirtyComposites, c)
+	requestFlush()
+}
+
+// requestFlush makes sure that Flush is called on the next animation frame.
+func requestFlush() {
+	if frameRequested {
+		return
+	}
+	frameRequested = true
+	dom.RequestAnimationFrame(func() {
+		frameRequested = false
+		Flush()
+	})
+}
+
+// resetScheduler unschedules all composites, e.g. when the DOM is replaced,
+// as an animation frame requested from the previous DOM may never come and
+// the scheduled composites belong to its documents.
+func resetScheduler() {
+	for _, c := range dirtyComposites {
+		c.dirty = false
+	}
+	dirtyComposites = nil
+	frameRequested = false
+}
+
+// Flush synchronously renders all composites scheduled by Rerender. If
+// rendering one of them panics, the composites which have not been rendered
+// yet stay scheduled.
+func Flush() {
+	var batch []*Composite
+	defer func() {
+		if len(batch) != 0 {
+			dirtyComposites = append(batch, dirtyComposites...)
+			requestFlush()
+		}
+	}()
+	for len(dirtyComposites) != 0 {
+		batch = dirtyComposites
+		dirtyComposites = nil
+		sort.Stable(byDepth(batch))
+		for len(batch) != 0 {
+			c := batch[0]
+			batch = batch[1:]
+			if !c.dirty || c.superseded || c.unmounted || c.Body == nil {
+				c.dirty = false
+				continue
+			}
+			c.ReconcileBody()
+		}
+	}
+}
+
+// byDepth sorts composites by their depth in the tree, parents first.
+type byDepth []*Composite
+
+func (s byDepth) Len() int           { return len(s) }
+func (s byDepth) Less(i, j int) bool { return s[i].depth() < s[j].depth() }
+func (s byDepth) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
@@ -0,0 +1,134 @@
+// +build !js
+
+package vecty_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/memdom"
+	"github.com/gopherjs/vecty/vectytest"
+)
+
+// panicky is a composite which counts its renders and panics while fail is
+// set.
+type panicky struct {
+	vecty.Composite
+
+	fail    bool
+	renders int
+}
+
+func (p *panicky) Apply(element *vecty.Element) {
+	element.AddChild(p)
+}
+
+func (p *panicky) Reconcile(oldComp vecty.Component) {
+	p.RenderFunc = func() vecty.Component {
+		if p.fail {
+			panic("render failed")
+		}
+		p.renders++
+		return elem.Div()
+	}
+	p.ReconcileBody()
+}
+
+func TestFlushPanic(t *testing.T) {
+	failing := &panicky{}
+	other := &panicky{}
+	doc := vectytest.Mount(elem.Div(failing, other))
+	defer doc.Close()
+
+	failing.fail = true
+	vecty.Rerender(failing)
+	vecty.Rerender(other)
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Flush did not panic")
+			}
+		}()
+		vecty.Flush()
+	}()
+
+	failing.fail = false
+	doc.AnimationFrame()
+	if other.renders != 2 {
+		t.Errorf("got %d renders of the composite scheduled after the panicking one, want 2", other.renders)
+	}
+	vecty.Rerender(other)
+	doc.AnimationFrame()
+	if other.renders != 3 {
+		t.Errorf("got %d renders after rerendering it again, want 3", other.renders)
+	}
+}
+
+// number is a composite which renders n as text.
+type number struct {
+	vecty.Composite
+
+	n int
+}
+
+func (c *number) Apply(element *vecty.Element) {
+	element.AddChild(c)
+}
+
+func (c *number) Reconcile(oldComp vecty.Component) {
+	c.RenderFunc = func() vecty.Component {
+		return vecty.Text(fmt.Sprint(c.n))
+	}
+	c.ReconcileBody()
+}
+
+// holder is a composite which keeps its children across renders.
+type holder struct {
+	vecty.Composite
+
+	children []vecty.Markup
+}
+
+func (h *holder) Apply(element *vecty.Element) {
+	element.AddChild(h)
+}
+
+func (h *holder) Reconcile(oldComp vecty.Component) {
+	h.RenderFunc = func() vecty.Component {
+		return elem.Div(h.children...)
+	}
+	h.ReconcileBody()
+}
+
+func TestRerenderReusedChild(t *testing.T) {
+	child := &number{}
+	h := &holder{children: []vecty.Markup{child}}
+	doc := vectytest.Mount(h)
+	defer doc.Close()
+
+	vecty.Rerender(h)
+	vecty.Flush()
+	doc.Root().Update(h)
+
+	child.n = 5
+	vecty.Rerender(child)
+	vecty.Flush()
+	if got := doc.Container().Text(); got != "5" {
+		t.Errorf("got text %q after rerendering the reused child, want %q", got, "5")
+	}
+}
+
+func TestSetDOMUnschedules(t *testing.T) {
+	p := &panicky{}
+	doc := vectytest.Mount(p)
+	defer doc.Close()
+	vecty.Rerender(p)
+
+	defer vecty.SetDOM(vecty.SetDOM(memdom.NewDocument()))
+	vecty.Flush()
+	if p.renders != 1 {
+		t.Errorf("got %d renders of the composite scheduled in the previous DOM, want 1", p.renders)
+	}
+}
@@ -0,0 +1,634 @@
+//go:generate go run ../elem/generate.go -svg
+
+// Package svg defines markup to create SVG elements. The elements are created
+// in the SVG namespace, which is inherited by their child elements.
+//
+// Generated from "SVG element reference" by Mozilla Contributors,
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element, licensed under
+// CC-BY-SA 2.5.
+package svg
+
+import "github.com/gopherjs/vecty"
+
+// Anchor creates a hyperlink to other web pages, files, locations in the same
+// page, email addresses, or any other URL.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/a
+func Anchor(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "a", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Animate provides a way to animate an attribute of an element over time.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/animate
+func Animate(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "animate", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// AnimateMotion provides a way to define how an element moves along a motion
+// path.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/animateMotion
+func AnimateMotion(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "animateMotion", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// AnimateTransform animates a transformation attribute on its target element,
+// thereby allowing animations to control translation, scaling, rotation,
+// and/or skewing.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/animateTransform
+func AnimateTransform(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "animateTransform", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Circle is an SVG basic shape, used to draw circles based on a center point
+// and a radius.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/circle
+func Circle(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "circle", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// ClipPath defines a clipping path, to be used by the clip-path property.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/clipPath
+func ClipPath(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "clipPath", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Defs is used to store graphical objects that will be used at a later time.
+// Objects created inside a <defs> element are not rendered directly.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/defs
+func Defs(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "defs", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Desc provides an accessible, long-text description of any SVG container
+// element or graphics element.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/desc
+func Desc(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "desc", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Ellipse is an SVG basic shape, used to create ellipses based on a center
+// coordinate, and both their x and y radius.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/ellipse
+func Ellipse(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "ellipse", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feBlend> SVG filter primitive composes two objects together ruled by a
+// certain blending mode.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feBlend
+func FEBlend(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feBlend", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feColorMatrix> SVG filter element changes colors based on a
+// transformation matrix.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feColorMatrix
+func FEColorMatrix(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feColorMatrix", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feComponentTransfer> SVG filter primitive performs color-component-wise
+// remapping of data for each pixel.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feComponentTransfer
+func FEComponentTransfer(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feComponentTransfer", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feComposite> SVG filter primitive performs the combination of two input
+// images pixel-wise in image space using one of the Porter-Duff compositing
+// operations.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feComposite
+func FEComposite(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feComposite", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feConvolveMatrix> SVG filter primitive applies a matrix convolution
+// filter effect.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feConvolveMatrix
+func FEConvolveMatrix(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feConvolveMatrix", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feDiffuseLighting> SVG filter primitive lights an image using the alpha
+// channel as a bump map.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feDiffuseLighting
+func FEDiffuseLighting(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feDiffuseLighting", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feDisplacementMap> SVG filter primitive uses the pixel values from the
+// image from in2 to spatially displace the image from in.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feDisplacementMap
+func FEDisplacementMap(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feDisplacementMap", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feDistantLight> SVG filter primitive defines a distant light source
+// that can be used within a lighting filter primitive.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feDistantLight
+func FEDistantLight(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feDistantLight", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feDropShadow> SVG filter primitive creates a drop shadow of the input
+// image.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feDropShadow
+func FEDropShadow(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feDropShadow", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feFlood> SVG filter primitive fills the filter subregion with the color
+// and opacity defined by flood-color and flood-opacity.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feFlood
+func FEFlood(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feFlood", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feFuncA> SVG filter primitive defines the transfer function for the
+// alpha component of the input graphic of its parent <feComponentTransfer>
+// element.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feFuncA
+func FEFuncA(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feFuncA", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feFuncB> SVG filter primitive defines the transfer function for the
+// blue component of the input graphic of its parent <feComponentTransfer>
+// element.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feFuncB
+func FEFuncB(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feFuncB", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feFuncG> SVG filter primitive defines the transfer function for the
+// green component of the input graphic of its parent <feComponentTransfer>
+// element.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feFuncG
+func FEFuncG(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feFuncG", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feFuncR> SVG filter primitive defines the transfer function for the red
+// component of the input graphic of its parent <feComponentTransfer> element.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feFuncR
+func FEFuncR(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feFuncR", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feGaussianBlur> SVG filter primitive blurs the input image by the
+// amount specified in stdDeviation, which defines the bell-curve.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feGaussianBlur
+func FEGaussianBlur(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feGaussianBlur", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feImage> SVG filter primitive fetches image data from an external
+// source and provides the pixel data as output.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feImage
+func FEImage(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feImage", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// FEMerge allows filter effects to be applied concurrently instead of
+// sequentially.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feMerge
+func FEMerge(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feMerge", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// FEMergeNode takes the result of another filter to be processed by its parent
+// <feMerge>.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feMergeNode
+func FEMergeNode(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feMergeNode", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feMorphology> SVG filter primitive is used to erode or dilate the input
+// image.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feMorphology
+func FEMorphology(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feMorphology", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feOffset> SVG filter primitive allows to offset the input image.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feOffset
+func FEOffset(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feOffset", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <fePointLight> SVG filter primitive defines a light source which allows
+// to create a point light effect.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/fePointLight
+func FEPointLight(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "fePointLight", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feSpecularLighting> SVG filter primitive lights a source graphic using
+// the alpha channel as a bump map.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feSpecularLighting
+func FESpecularLighting(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feSpecularLighting", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feSpotLight> SVG filter primitive defines a light source which allows
+// to create a spotlight effect.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feSpotLight
+func FESpotLight(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feSpotLight", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feTile> SVG filter primitive allows to fill a target rectangle with a
+// repeated, tiled pattern of an input image.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feTile
+func FETile(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feTile", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <feTurbulence> SVG filter primitive creates an image using the Perlin
+// turbulence function.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feTurbulence
+func FETurbulence(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "feTurbulence", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Filter defines a custom filter effect by grouping atomic filter primitives.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/filter
+func Filter(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "filter", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// ForeignObject includes elements from a different XML namespace. In the
+// context of a browser, it is most likely (X)HTML.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/foreignObject
+func ForeignObject(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "foreignObject", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Group is a container used to group other SVG elements.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/g
+func Group(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "g", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Image includes images inside SVG documents.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/image
+func Image(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "image", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Line is an SVG basic shape used to create a line connecting two points.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/line
+func Line(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "line", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// LinearGradient lets authors define linear gradients to apply to other SVG
+// elements.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/linearGradient
+func LinearGradient(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "linearGradient", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Marker defines a graphic used for drawing arrowheads or polymarkers on a
+// given <path>, <line>, <polyline> or <polygon> element.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/marker
+func Marker(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "marker", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Mask defines a mask for compositing the current object into the background.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/mask
+func Mask(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "mask", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Metadata adds metadata to SVG content.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/metadata
+func Metadata(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "metadata", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// The <mpath> SVG sub-element for the <animateMotion> element provides the
+// ability to reference an external <path> element as the definition of a
+// motion path.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/mpath
+func Mpath(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "mpath", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Path is the generic element to define a shape. All the basic shapes can be
+// created with a path element.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/path
+func Path(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "path", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Pattern defines a graphics object which can be redrawn at repeated x- and
+// y-coordinate intervals ("tiled") to cover an area.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/pattern
+func Pattern(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "pattern", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Polygon defines a closed shape consisting of a set of connected straight
+// line segments.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/polygon
+func Polygon(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "polygon", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Polyline is an SVG basic shape that creates straight lines connecting
+// several points.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/polyline
+func Polyline(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "polyline", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// RadialGradient lets authors define radial gradients that can be applied to
+// fill or stroke of graphical elements.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/radialGradient
+func RadialGradient(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "radialGradient", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Rect is a basic SVG shape that draws rectangles, defined by their position,
+// width, and height.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/rect
+func Rect(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "rect", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Script allows to add scripts to an SVG document.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/script
+func Script(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "script", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Set provides a simple means of just setting the value of an attribute for a
+// specified duration.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/set
+func Set(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "set", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Stop defines a color and its position to use on a gradient. This element is
+// always a child of a <linearGradient> or <radialGradient> element.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/stop
+func Stop(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "stop", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Style allows style sheets to be embedded directly within SVG content.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/style
+func Style(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "style", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// SVG is a container that defines a new coordinate system and viewport. It is
+// used as the outermost element of SVG documents, but it can also be used to
+// embed an SVG fragment inside an SVG or HTML document.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/svg
+func SVG(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "svg", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Switch evaluates any requiredFeatures, requiredExtensions and systemLanguage
+// attributes on its direct child elements in order, and then renders the first
+// child where these attributes evaluate to true.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/switch
+func Switch(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "switch", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Symbol is used to define graphical template objects which can be
+// instantiated by a <use> element.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/symbol
+func Symbol(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "symbol", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Text draws a graphics element consisting of text.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/text
+func Text(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "text", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// TextPath renders text along the shape of a <path>.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/textPath
+func TextPath(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "textPath", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Title provides an accessible, short-text description of any SVG container
+// element or graphics element.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/title
+func Title(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "title", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// TSpan defines a subtext within a <text> element or another <tspan> element.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/tspan
+func TSpan(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "tspan", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// Use takes nodes from within the SVG document, and duplicates them somewhere
+// else.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/use
+func Use(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "use", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
+
+// View defines a particular view of an SVG document.
+//
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/view
+func View(markup ...vecty.Markup) *vecty.Element {
+	e := &vecty.Element{TagName: "view", Namespace: vecty.SVGNamespace}
+	vecty.List(markup).Apply(e)
+	return e
+}
@@ -0,0 +1,50 @@
+// +build !js
+
+package vectytest
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+)
+
+var update = flag.Bool("vectytest.update", false, "update the golden files of snapshot tests")
+
+// Snapshot compares the markup of comp with the golden file
+// testdata/<name>.golden and reports a difference as a test error. If the
+// -vectytest.update flag is given, the golden file is written instead.
+//
+// The markup is written as indented HTML by vecty.RenderHTMLIndent. comp is
+// expected to have been rendered before, e.g. by Mount, so that the snapshot
+// reflects its current state.
+func Snapshot(t testing.TB, name string, comp vecty.Component) {
+	var buf bytes.Buffer
+	if err := vecty.RenderHTMLIndent(&buf, comp, "  "); err != nil {
+		t.Fatalf("snapshot %s: %s", name, err)
+	}
+	got := buf.Bytes()
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.MkdirAll("testdata", 0755); err != nil {
+			t.Fatalf("snapshot %s: %s", name, err)
+		}
+		if err := ioutil.WriteFile(path, got, 0644); err != nil {
+			t.Fatalf("snapshot %s: %s", name, err)
+		}
+		return
+	}
+
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("snapshot %s: %s (run with -vectytest.update to create it)", name, err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("snapshot %s does not match %s:\n--- got\n%s\n--- want\n%s", name, path, got, want)
+	}
+}
@@ -0,0 +1,75 @@
+// +build !js
+
+package vectytest_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/gopherjs/vecty/vectytest"
+)
+
+type todoList struct {
+	vecty.Composite
+
+	items []string
+	done  map[string]bool
+}
+
+func (l *todoList) Apply(element *vecty.Element) {
+	element.AddChild(l)
+}
+
+func (l *todoList) Reconcile(oldComp vecty.Component) {
+	l.RenderFunc = l.render
+	l.ReconcileBody()
+}
+
+func (l *todoList) render() vecty.Component {
+	var items vecty.List
+	for i, item := range l.items {
+		items = append(items, elem.ListItem(
+			vecty.ClassMap{"item": true, "done": l.done[item], "first": i == 0},
+			vecty.Style("padding", "0"),
+			vecty.Style("color", "black"),
+			vecty.Data("index", strconv.Itoa(i)),
+			vecty.Text(item),
+		))
+	}
+	return elem.UnorderedList(
+		prop.ID("todos"),
+		items,
+	)
+}
+
+func TestSnapshot(t *testing.T) {
+	l := &todoList{items: []string{"milk", "eggs"}, done: map[string]bool{"eggs": true}}
+	doc := vectytest.Mount(l)
+	defer doc.Close()
+	vectytest.Snapshot(t, "todo-list", l)
+}
+
+// errorRecorder records the errors reported to it instead of failing the test.
+type errorRecorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *errorRecorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, format)
+}
+
+func TestSnapshotMismatch(t *testing.T) {
+	l := &todoList{items: []string{"milk"}}
+	doc := vectytest.Mount(l)
+	defer doc.Close()
+	r := &errorRecorder{TB: t}
+	vectytest.Snapshot(r, "todo-list", l)
+	if len(r.errors) != 1 || !strings.Contains(r.errors[0], "does not match") {
+		t.Errorf("got errors %q, want a mismatch", r.errors)
+	}
+}
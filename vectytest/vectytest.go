@@ -0,0 +1,150 @@
+// +build !js
+
+// Package vectytest provides a fake document for testing Vecty components
+// with go test, outside of the browser:
+//
+//  doc := vectytest.Mount(&MyComponent{})
+//  defer doc.Close()
+//  doc.Click(doc.ByClass("destroy")[0])
+//  if items := doc.ByTag("li"); len(items) != 0 {
+//  	t.Errorf("got %d items, want none", len(items))
+//  }
+package vectytest
+
+import (
+	"strings"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/memdom"
+)
+
+// Document is a fake document which a component has been mounted into.
+type Document struct {
+	*memdom.Document
+
+	container *memdom.Node
+	root      *vecty.Root
+	prevDOM   vecty.DOM
+}
+
+// Mount renders comp into a new fake document with the given options, as
+// Render does. The fake document is used by Vecty for all DOM operations until
+// Close is called.
+func Mount(comp vecty.Component, opts ...vecty.RenderOption) *Document {
+	d := &Document{Document: memdom.NewDocument()}
+	d.prevDOM = vecty.SetDOM(d.Document)
+	container := d.CreateElement("body")
+	d.container = d.Node(container)
+	d.root = vecty.Render(comp, container, opts...)
+	return d
+}
+
+// Root returns the root which the component has been rendered as, e.g. to
+// update or unmount it.
+func (d *Document) Root() *vecty.Root {
+	return d.root
+}
+
+// Close unmounts the component, unless it has been unmounted already, and
+// restores the DOM which was used before Mount.
+func (d *Document) Close() {
+	d.root.Unmount()
+	vecty.SetDOM(d.prevDOM)
+}
+
+// Container returns the node which the component has been rendered into.
+func (d *Document) Container() *memdom.Node {
+	return d.container
+}
+
+// Find returns all nodes below the container for which match returns true, in
+// document order.
+func (d *Document) Find(match func(n *memdom.Node) bool) []*memdom.Node {
+	var nodes []*memdom.Node
+	var walk func(n *memdom.Node)
+	walk = func(n *memdom.Node) {
+		for _, c := range n.Children {
+			if match(c) {
+				nodes = append(nodes, c)
+			}
+			walk(c)
+		}
+	}
+	walk(d.container)
+	return nodes
+}
+
+// ByTag returns all elements with the given tag name.
+func (d *Document) ByTag(tagName string) []*memdom.Node {
+	return d.Find(func(n *memdom.Node) bool {
+		return n.TagName() == tagName
+	})
+}
+
+// ByClass returns all elements which have the given class.
+func (d *Document) ByClass(class string) []*memdom.Node {
+	return d.Find(func(n *memdom.Node) bool {
+		className, _ := n.Properties["className"].(string)
+		if class, ok := n.Attributes["class"]; ok {
+			className = class
+		}
+		for _, c := range strings.Fields(className) {
+			if c == class {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// ByID returns the element with the given id, or nil.
+func (d *Document) ByID(id string) *memdom.Node {
+	nodes := d.Find(func(n *memdom.Node) bool {
+		return n.Properties["id"] == id || n.Attributes["id"] == id
+	})
+	if len(nodes) == 0 {
+		return nil
+	}
+	return nodes[0]
+}
+
+// ByData returns all elements whose custom data attribute of the given name
+// has the given value.
+func (d *Document) ByData(name, value string) []*memdom.Node {
+	return d.Find(func(n *memdom.Node) bool {
+		v, ok := n.Dataset[name]
+		return ok && v == value
+	})
+}
+
+// Fire dispatches an event of the given name to n, which bubbles up through
+// the ancestors of n. It reports whether a listener prevented the default
+// action of the event.
+func (d *Document) Fire(n *memdom.Node, name string) (defaultPrevented bool) {
+	return d.Dispatch(n, name).DefaultPrevented()
+}
+
+// Click fires a click event on n.
+func (d *Document) Click(n *memdom.Node) (defaultPrevented bool) {
+	return d.Fire(n, "click")
+}
+
+// Input sets the value of the input element n, as if typed by the user, and
+// fires an input event on it.
+func (d *Document) Input(n *memdom.Node, value string) (defaultPrevented bool) {
+	n.Properties["value"] = value
+	return d.Fire(n, "input")
+}
+
+// Check sets the checked state of the checkbox or radio button n, as if
+// clicked by the user, and fires a change event on it.
+func (d *Document) Check(n *memdom.Node, checked bool) (defaultPrevented bool) {
+	n.Properties["checked"] = checked
+	return d.Fire(n, "change")
+}
+
+// KeyDown fires a keydown event for the given key, e.g. "Enter", on n.
+func (d *Document) KeyDown(n *memdom.Node, key string) (defaultPrevented bool) {
+	e := &memdom.Event{Name: "keydown", Properties: map[string]interface{}{"key": key}}
+	return d.DispatchEvent(n, e).DefaultPrevented()
+}
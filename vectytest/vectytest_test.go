@@ -0,0 +1,175 @@
+// +build !js
+
+package vectytest_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gopherjs/vecty"
+	"github.com/gopherjs/vecty/elem"
+	"github.com/gopherjs/vecty/event"
+	"github.com/gopherjs/vecty/prop"
+	"github.com/gopherjs/vecty/vectytest"
+)
+
+type counter struct {
+	vecty.Composite
+
+	count   int
+	text    string
+	checked bool
+	keys    []string
+	clicks  []string
+}
+
+func (c *counter) Apply(element *vecty.Element) {
+	element.AddChild(c)
+}
+
+func (c *counter) Reconcile(oldComp vecty.Component) {
+	c.RenderFunc = c.render
+	c.ReconcileBody()
+}
+
+func (c *counter) render() vecty.Component {
+	return elem.Div(
+		prop.ID("counter"),
+		event.Click(func(*vecty.MouseEvent) {
+			c.clicks = append(c.clicks, "div")
+		}),
+		elem.Button(
+			prop.Class("increment primary"),
+			vecty.Data("action", "increment"),
+			event.Click(func(*vecty.MouseEvent) {
+				c.count++
+				c.clicks = append(c.clicks, "increment")
+			}),
+		),
+		elem.Anchor(
+			prop.Href("#"),
+			event.Click(func(*vecty.MouseEvent) {
+				c.clicks = append(c.clicks, "link")
+			}).PreventDefault().StopPropagation(),
+		),
+		elem.Input(
+			event.Input(func(e *vecty.InputEvent) {
+				c.text = e.Value()
+			}),
+			event.KeyDown(func(e *vecty.KeyboardEvent) {
+				c.keys = append(c.keys, e.Key())
+			}),
+		),
+		elem.Input(
+			prop.Type(prop.TypeCheckbox),
+			event.Change(func(e *vecty.Event) {
+				c.checked = e.Checked()
+			}),
+		),
+	)
+}
+
+func TestQueries(t *testing.T) {
+	doc := vectytest.Mount(&counter{})
+	defer doc.Close()
+
+	if n := doc.ByID("counter"); n == nil || n.TagName() != "div" {
+		t.Errorf("got %v by id, want the <div>", n)
+	}
+	if n := doc.ByID("missing"); n != nil {
+		t.Errorf("got %v for a missing id, want nil", n)
+	}
+	if got := doc.ByTag("input"); len(got) != 2 {
+		t.Errorf("got %d inputs, want 2", len(got))
+	}
+	if got := doc.ByClass("primary"); len(got) != 1 || got[0].TagName() != "button" {
+		t.Errorf("got %v by class, want the <button>", got)
+	}
+	if got := doc.ByClass("incr"); len(got) != 0 {
+		t.Errorf("got %v for part of a class, want none", got)
+	}
+	if got := doc.ByData("action", "increment"); len(got) != 1 || got[0].TagName() != "button" {
+		t.Errorf("got %v by data, want the <button>", got)
+	}
+	if got := doc.Container().Children; len(got) != 1 || got[0] != doc.ByID("counter") {
+		t.Errorf("got %v in the container, want the <div>", got)
+	}
+}
+
+func TestEvents(t *testing.T) {
+	c := &counter{}
+	doc := vectytest.Mount(c)
+	defer doc.Close()
+
+	button := doc.ByTag("button")[0]
+	if doc.Click(button) {
+		t.Error("click on the button prevented its default action")
+	}
+	doc.Click(button)
+	if c.count != 2 {
+		t.Errorf("got count %d, want 2", c.count)
+	}
+
+	if !doc.Click(doc.ByTag("a")[0]) {
+		t.Error("click on the link did not prevent its default action")
+	}
+	want := []string{"increment", "div", "increment", "div", "link"}
+	if !reflect.DeepEqual(c.clicks, want) {
+		t.Errorf("got clicks %q, want %q", c.clicks, want)
+	}
+
+	inputs := doc.ByTag("input")
+	doc.Input(inputs[0], "hello")
+	doc.KeyDown(inputs[0], "Enter")
+	doc.Check(inputs[1], true)
+	if c.text != "hello" || len(c.keys) != 1 || c.keys[0] != "Enter" || !c.checked {
+		t.Errorf("got text %q, keys %q and checked %v", c.text, c.keys, c.checked)
+	}
+}
+
+func TestUnmount(t *testing.T) {
+	c := &counter{}
+	doc := vectytest.Mount(c)
+	defer doc.Close()
+
+	button := doc.ByTag("button")[0]
+	doc.Root().Unmount()
+	if got := doc.Container().Children; len(got) != 0 {
+		t.Errorf("got %v in the container after Unmount, want nothing", got)
+	}
+	doc.Click(button)
+	if c.count != 0 {
+		t.Error("listener called after Unmount")
+	}
+}
+
+// unmounter is a composite which calls unmount when it is unmounted.
+type unmounter struct {
+	vecty.Composite
+
+	unmount func()
+}
+
+func (u *unmounter) Apply(element *vecty.Element) {
+	element.AddChild(u)
+}
+
+func (u *unmounter) Reconcile(oldComp vecty.Component) {
+	u.RenderFunc = func() vecty.Component {
+		return elem.Div()
+	}
+	u.ReconcileBody()
+}
+
+func (u *unmounter) Unmount() {
+	u.unmount()
+}
+
+func TestClose(t *testing.T) {
+	var unmounted bool
+	doc := vectytest.Mount(&unmounter{unmount: func() { unmounted = true }})
+	doc.Close()
+	if !unmounted {
+		t.Error("Close did not unmount the component")
+	}
+}